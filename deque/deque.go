@@ -0,0 +1,185 @@
+// Package deque contains a double-ended queue on doubly linked list in Go.
+package deque
+
+import (
+    "iter"
+    "github.com/hiennguyen-neih/go-linkedlist/golist2"
+    "github.com/hiennguyen-neih/go-linkedlist/node"
+)
+
+/*
+ *******************************************************************************
+ * Define structs and interfaces
+ *******************************************************************************
+ */
+
+// Double-ended queue with O(1) push, pop and peek at both ends. The zero value
+// is an empty deque ready to use.
+//
+// Nodes of the deque are not exported, so unlike golist2.GoList2 it can not be
+// corrupted from outside.
+type Deque[T any] struct {
+    head *node.Node2[T]    // First node of the deque.
+    tail *node.Node2[T]    // Last node of the deque.
+    size int               // Number of nodes in the deque.
+}
+
+/*
+ *******************************************************************************
+ * Exported functions
+ *******************************************************************************
+ */
+
+// Returns a new deque containing all input elements, from front to back.
+func New[T any](elems ...T) *Deque[T] {
+    deque := &Deque[T]{}
+    for _, elem := range elems {
+        deque.PushBack(elem)
+    }
+    return deque
+}
+
+// Returns a new deque containing node data of list, from front to back.
+func FromList[T any](list golist2.GoList2[T]) *Deque[T] {
+    deque := &Deque[T]{}
+    for node := list.Head; node != nil; node = node.Next {
+        deque.PushBack(node.Data)
+    }
+    return deque
+}
+
+/*
+ *******************************************************************************
+ * Exported methods
+ *******************************************************************************
+ */
+
+// Returns an iterator over elements of deque, from front to back.
+func (deque *Deque[T]) All() iter.Seq[T] {
+    return func(yield func(T) bool) {
+        for node := deque.head; node != nil; node = node.Next {
+            if !yield(node.Data) {
+                return
+            }
+        }
+    }
+}
+
+// Returns an iterator over elements of deque, from back to front.
+func (deque *Deque[T]) Backward() iter.Seq[T] {
+    return func(yield func(T) bool) {
+        for node := deque.tail; node != nil; node = node.Prev {
+            if !yield(node.Data) {
+                return
+            }
+        }
+    }
+}
+
+// Returns number of elements of deque.
+func (deque *Deque[T]) Len() int {
+    return deque.size
+}
+
+// Returns the front element and true, or zero value and false if deque is
+// empty.
+func (deque *Deque[T]) PeekFront() (T, bool) {
+    if deque.head == nil {
+        var zero T
+        return zero, false
+    }
+    return deque.head.Data, true
+}
+
+// Returns the back element and true, or zero value and false if deque is
+// empty.
+func (deque *Deque[T]) PeekBack() (T, bool) {
+    if deque.tail == nil {
+        var zero T
+        return zero, false
+    }
+    return deque.tail.Data, true
+}
+
+// Returns a string representing deque, same as golist2.GoList2.
+func (deque *Deque[T]) String() string {
+    return deque.ToList().String()
+}
+
+// Returns a new GoList2 containing elements of deque, from front to back.
+func (deque *Deque[T]) ToList() golist2.GoList2[T] {
+    return golist2.FromSeq(deque.All())
+}
+
+/*
+ *******************************************************************************
+ * Exported in-place methods
+ *******************************************************************************
+ */
+
+// Removes all elements of deque.
+func (deque *Deque[T]) Clear() {
+    *deque = Deque[T]{}
+}
+
+// Inserts value at the front of deque.
+func (deque *Deque[T]) PushFront(value T) {
+    node := &node.Node2[T]{Data: value, Next: deque.head}
+    if deque.head == nil {
+        deque.tail = node
+    } else {
+        deque.head.Prev = node
+    }
+    deque.head = node
+    deque.size++
+}
+
+// Inserts value at the back of deque.
+func (deque *Deque[T]) PushBack(value T) {
+    node := &node.Node2[T]{Prev: deque.tail, Data: value}
+    if deque.tail == nil {
+        deque.head = node
+    } else {
+        deque.tail.Next = node
+    }
+    deque.tail = node
+    deque.size++
+}
+
+// Removes the front element. Returns it and true, or zero value and false if
+// deque is empty.
+func (deque *Deque[T]) PopFront() (T, bool) {
+    node := deque.head
+    if node == nil {
+        var zero T
+        return zero, false
+    }
+    deque.head = node.Next
+    if deque.head == nil {
+        deque.tail = nil
+    } else {
+        deque.head.Prev = nil
+    }
+    node.Next = nil
+    deque.size--
+    return node.Data, true
+}
+
+// Removes the back element. Returns it and true, or zero value and false if
+// deque is empty.
+func (deque *Deque[T]) PopBack() (T, bool) {
+    node := deque.tail
+    if node == nil {
+        var zero T
+        return zero, false
+    }
+    deque.tail = node.Prev
+    if deque.tail == nil {
+        deque.head = nil
+    } else {
+        deque.tail.Next = nil
+    }
+    node.Prev = nil
+    deque.size--
+    return node.Data, true
+}
@@ -0,0 +1,120 @@
+package deque
+
+import (
+    "container/list"
+    "slices"
+    "testing"
+    "github.com/hiennguyen-neih/go-linkedlist/golist2"
+)
+
+func TestNew(t *testing.T) {
+    deque := New(1, 2, 3)
+    if result, expected := slices.Collect(deque.All()), []int{1, 2, 3}; !slices.Equal(result, expected) {
+        t.Errorf("New\nresult: %v\nexpected: %v", result, expected)
+    }
+    if result, expected := slices.Collect(deque.Backward()), []int{3, 2, 1}; !slices.Equal(result, expected) {
+        t.Errorf("Backward\nresult: %v\nexpected: %v", result, expected)
+    }
+    if result, expected := deque.String(), "[1<->2<->3]"; result != expected {
+        t.Errorf("String\nresult: %v\nexpected: %v", result, expected)
+    }
+}
+
+func TestPushPop(t *testing.T) {
+    var deque Deque[int]
+    deque.PushBack(2)
+    deque.PushFront(1)
+    deque.PushBack(3)
+    if result, _ := deque.PeekFront(); result != 1 {
+        t.Errorf("PeekFront\nresult: %v\nexpected: %v", result, 1)
+    }
+    if result, _ := deque.PeekBack(); result != 3 {
+        t.Errorf("PeekBack\nresult: %v\nexpected: %v", result, 3)
+    }
+    if result, _ := deque.PopBack(); result != 3 {
+        t.Errorf("PopBack\nresult: %v\nexpected: %v", result, 3)
+    }
+    if result, _ := deque.PopFront(); result != 1 {
+        t.Errorf("PopFront\nresult: %v\nexpected: %v", result, 1)
+    }
+    if result, _ := deque.PopFront(); result != 2 || deque.Len() != 0 {
+        t.Errorf("PopFront\nresult: %v, %v\nexpected: 2, 0", result, deque.Len())
+    }
+    if _, ok := deque.PopBack(); ok {
+        t.Errorf("PopBack\nresult: true\nexpected: false")
+    }
+    if _, ok := deque.PeekFront(); ok {
+        t.Errorf("PeekFront\nresult: true\nexpected: false")
+    }
+
+    // Deque is still usable after being emptied from either end.
+    deque.PushFront(4)
+    if result, _ := deque.PopBack(); result != 4 {
+        t.Errorf("PopBack\nresult: %v\nexpected: %v", result, 4)
+    }
+}
+
+func TestList(t *testing.T) {
+    list := golist2.New("a", "b", "c")
+    deque := FromList(list)
+    deque.PushFront("z")
+    deque.Clear()
+    deque.PushBack("x")
+    if result, expected := deque.ToList(), golist2.New("x"); !golist2.Equal(result, expected) {
+        t.Errorf("ToList\nresult: %v\nexpected: %v", result, expected)
+    }
+    if result, expected := FromList(list).ToList(), list; !golist2.Equal(result, expected) {
+        t.Errorf("FromList\nresult: %v\nexpected: %v", result, expected)
+    }
+    if err := golist2.Validate(deque.ToList()); err != nil {
+        t.Errorf("ToList\nunexpected error: %v", err)
+    }
+}
+
+func BenchmarkDeque_PushPop(b *testing.B) {
+    var deque Deque[int]
+    for i := 0; i < b.N; i++ {
+        deque.PushBack(i)
+        deque.PushFront(i)
+        deque.PopBack()
+        deque.PopFront()
+    }
+}
+
+func BenchmarkContainerList_PushPop(b *testing.B) {
+    queue := list.New()
+    for i := 0; i < b.N; i++ {
+        queue.PushBack(i)
+        queue.PushFront(i)
+        queue.Remove(queue.Back())
+        queue.Remove(queue.Front())
+    }
+}
+
+func BenchmarkDeque_Iterate(b *testing.B) {
+    var deque Deque[int]
+    for i := 0; i < 1000; i++ {
+        deque.PushBack(i)
+    }
+    b.ResetTimer()
+    for i := 0; i < b.N; i++ {
+        sum := 0
+        for value := range deque.All() {
+            sum += value
+        }
+    }
+}
+
+func BenchmarkContainerList_Iterate(b *testing.B) {
+    queue := list.New()
+    for i := 0; i < 1000; i++ {
+        queue.PushBack(i)
+    }
+    b.ResetTimer()
+    for i := 0; i < b.N; i++ {
+        sum := 0
+        for element := queue.Front(); element != nil; element = element.Next() {
+            sum += element.Value.(int)
+        }
+    }
+}
@@ -40,5 +40,8 @@ func main() {
     // GoListC (singly circular list)
     fmt.Println("Examples for GoListC (singly circular list)")
     listc := golistc.New(1.9, 2.8, 3.7, 4.6)
-    fmt.Println(listc)
+    fmt.Println(listc)  // [1.9=>2.8=>3.7=>4.6=>]
+    listc = golistc.Rotate(listc, 2)
+    fmt.Println(listc)  // [3.7=>4.6=>1.9=>2.8=>]
+    fmt.Println(golistc.Nth(listc, 5))  // 4.6
 }
@@ -1,7 +1,5 @@
 module github.com/hiennguyen-neih/go-linkedlist
 
-go 1.21
+go 1.23
 
 toolchain go1.23.4
-
-require github.com/google/go-cmp v0.7.0 // indirect
@@ -2,9 +2,18 @@
 package golist
 
 import (
+    "bytes"
+    "container/heap"
+    "encoding/gob"
+    "encoding/json"
+    "errors"
     "fmt"
+    "io"
+    "iter"
+    "reflect"
+    "strconv"
     "strings"
-    "github.com/google/go-cmp/cmp"
+    "github.com/hiennguyen-neih/go-linkedlist/internal/listtext"
     "github.com/hiennguyen-neih/go-linkedlist/node"
     "github.com/hiennguyen-neih/go-linkedlist/constraints"
 )
@@ -18,6 +27,24 @@ import (
 // Struct of Go singly linked list.
 type GoList[T any] struct {
     Head *node.Node[T]    // First node of the list.
+    Tail *node.Node[T]    // Last node of the list.
+    Size int              // Number of nodes in the list.
+}
+
+// Errors returned by the Try, Parse and Validate functions of the package.
+var (
+    ErrEmptyList       = errors.New("golist: list is empty")
+    ErrIndexOutOfRange = errors.New("golist: index out of range")
+    ErrNegativeLength  = errors.New("golist: negative length")
+    ErrSyntax          = errors.New("golist: invalid list syntax")
+    ErrCorrupted       = errors.New("golist: corrupted list")
+)
+
+// Text format of the list, such as ["a"->"b"], written by WriteTo and read by
+// ParseReader.
+var textFormat = listtext.Format{
+    Sep:       "->",
+    ErrSyntax: ErrSyntax,
 }
 
 /*
@@ -44,6 +71,15 @@ func FromSlice[T any](values []T) GoList[T] {
     return *list.reverse()
 }
 
+// Collects values from input iterator into new singly linked list.
+func FromSeq[T any](seq iter.Seq[T]) GoList[T] {
+    var list GoList[T]
+    for val := range seq {
+        list.appendHead(val)
+    }
+    return *list.reverse()
+}
+
 // Convert input singly linked list into new slice.
 func ToSlice[T any](list GoList[T]) []T {
     var result []T
@@ -99,6 +135,12 @@ func AppendHead[T any](list GoList[T], values ...T) GoList[T] {
     return *result.reverse()
 }
 
+// Collects values from input iterator into new list. This function is the
+// same as FromSeq, named after slices.Collect.
+func Collect[T any](seq iter.Seq[T]) GoList[T] {
+    return FromSeq(seq)
+}
+
 // Returns a list that is concatenated of all input lists.
 func Concat[T any](lists ...GoList[T]) GoList[T] {
     var result GoList[T]
@@ -110,16 +152,25 @@ func Concat[T any](lists ...GoList[T]) GoList[T] {
     return *result.reverse()
 }
 
+// Returns the node where a cycle in the Next pointers of list starts, or nil if
+// list has no cycle. Uses Floyd's algorithm, in O(n) time and O(1) space.
+func CycleStart[T any](list GoList[T]) *node.Node[T] {
+    return cycleStart(list.Head)
+}
+
 // Returns a copy of input list where the first node data that matching value
-// is removed.
-func Delete[T any](list GoList[T], value T) GoList[T] {
+// is removed. Node data are compared with ==, see DeleteFunc for other cases.
+func Delete[T comparable](list GoList[T], value T) GoList[T] {
+    return DeleteFunc(list, func(data T) bool { return data == value })
+}
+
+// Returns a copy of input list where the first node that fun returns true is
+// removed.
+func DeleteFunc[T any](list GoList[T], fun func(T) bool) GoList[T] {
     var result GoList[T]
-    if list.Head == nil {
-        return result
-    }
     node := list.Head
     for node != nil {
-        if !cmp.Equal(node.Data, value) {
+        if !fun(node.Data) {
             result.appendHead(node.Data)
             node = node.Next
         } else {
@@ -136,47 +187,31 @@ func Delete[T any](list GoList[T], value T) GoList[T] {
 
 // Deletes node at the specific index of list. If index is out of bound, the
 // original list is returned. Negative index indicate an offset from the end
-// of list.
+// of list. See TryDeleteAt for a variant returning an error.
 func DeleteAt[T any](list GoList[T], index int) GoList[T] {
-    var result GoList[T]
-    len := Len(list)
-
-    if list.Head == nil {
-        return result
-    }
-
-    if index < 0 {
-        index = len + index // same as len - abs(index)
-    }
-
-    if index < 0 || index >= len {
-        for node := list.Head; node != nil; node = node.Next {
-            result.appendHead(node.Data)
-        }
-    } else {
-        i := 0
-        for node := list.Head; node != nil; node = node.Next {
-            if i == index {
-                i++
-                continue
-            }
-            i++
-            result.appendHead(node.Data)
-        }
+    result, err := TryDeleteAt(list, index)
+    if err != nil {
+        return Concat(list)
     }
+    return result
+}
 
-    return *result.reverse()
+// Returns a list of distinct nodes data of list1 that are not in list2, in
+// order of their first occurrence in list1. Unlike Subtract, duplicates are
+// removed from the result.
+func Difference[T comparable](list1, list2 GoList[T]) GoList[T] {
+    set2 := toSet(list2)
+    return Uniq(Filter(list1, func(data T) bool {
+        _, found := set2[data]
+        return !found
+    }))
 }
 
 // Drops the last node of input list. If input list is an empty list, returns
 // an empty list.
 func DropLast[T any](list GoList[T]) GoList[T] {
     var result GoList[T]
-    for node := list.Head; node != nil; node = node.Next {
-        if node.Next.Next == nil {
-            result.appendHead(node.Data)
-            break
-        }
+    for node := list.Head; node != nil && node != list.Tail; node = node.Next {
         result.appendHead(node.Data)
     }
     return *result.reverse()
@@ -210,11 +245,18 @@ func Duplicate[T any](n int, elem T) GoList[T] {
 }
 
 // Returns true if all corresponding nodes in both list1 and list2 have the
-// same value, otherwise return false.
-func Equal[T any](list1, list2 GoList[T]) bool {
+// same value, otherwise return false. Node data are compared with ==, see
+// EqualFunc for other cases.
+func Equal[T comparable](list1, list2 GoList[T]) bool {
+    return EqualFunc(list1, list2, func(data1, data2 T) bool { return data1 == data2 })
+}
+
+// Returns true if list1 and list2 have the same length and eq returns true for
+// all corresponding nodes data, otherwise returns false.
+func EqualFunc[T1, T2 any](list1 GoList[T1], list2 GoList[T2], eq func(T1, T2) bool) bool {
     node2 := list2.Head
     for node1 := list1.Head; node1 != nil; node1 = node1.Next {
-        if node2 == nil || !cmp.Equal(node1.Data, node2.Data) {
+        if node2 == nil || !eq(node1.Data, node2.Data) {
             return false
         }
         node2 = node2.Next
@@ -241,9 +283,10 @@ func Filter[T any](list GoList[T], fun func(T) bool) GoList[T] {
 
 // Calls fun on successive nodes of list to update or remove nodes from list.
 // Input fun must return (bool, value). The functions returns a list that nodes
-// data are value in which fun returns (true, value).
-func FilterMap[T any](list GoList[T], fun func(T) (bool, T)) GoList[T] {
-    var result GoList[T]
+// data are value in which fun returns (true, value). Type of value may differ
+// from type of input list data.
+func FilterMap[T1, T2 any](list GoList[T1], fun func(T1) (bool, T2)) GoList[T2] {
+    var result GoList[T2]
     if list.Head == nil {
         return result
     }
@@ -256,16 +299,24 @@ func FilterMap[T any](list GoList[T], fun func(T) (bool, T)) GoList[T] {
 }
 
 // Returns position of first node of list that match with value. If there is
-// no matching node, returns -1.
-func Find[T any](list GoList[T], value T) int {
-    i := 0
+// no matching node, returns -1. Node data are compared with ==, see IndexFunc
+// for other cases.
+func Find[T comparable](list GoList[T], value T) int {
+    return IndexFunc(list, func(data T) bool { return data == value })
+}
+
+// Calls fun(data) to every nodes in list and returns a list that is
+// concatenated of all lists returned by that fun. Type of returned lists data
+// may differ from type of input list data.
+func FlatMap[T1, T2 any](list GoList[T1], fun func(T1) GoList[T2]) GoList[T2] {
+    var result GoList[T2]
     for node := list.Head; node != nil; node = node.Next {
-        if cmp.Equal(node.Data, value) {
-            return i
+        mapped := fun(node.Data)
+        for sub := mapped.Head; sub != nil; sub = sub.Next {
+            result.appendHead(sub.Data)
         }
-        i++
     }
-    return -1
+    return *result.reverse()
 }
 
 // Calls fun(data, acc) on successive nodes of list from left to right (from
@@ -300,35 +351,44 @@ func ForEach[T any](list GoList[T], fun func(T)) {
     }
 }
 
+// Returns true if the Next pointers of list form a cycle, so walking list
+// would never end.
+func HasCycle[T any](list GoList[T]) bool {
+    return cycleStart(list.Head) != nil
+}
+
+// Returns position of first node of list that fun returns true. If every fun
+// execution returns false, returns -1.
+func IndexFunc[T any](list GoList[T], fun func(T) bool) int {
+    i := 0
+    for node := list.Head; node != nil; node = node.Next {
+        if fun(node.Data) {
+            return i
+        }
+        i++
+    }
+    return -1
+}
+
 // Returns a list with val is inserted at specific index. index is capped at
-// list length. Negative index indicate an offset from the end of list.
+// list length. Negative index indicate an offset from the end of list. Panics
+// if index is out of bound, see TryInsertAt for a variant returning an error.
 func InsertAt[T any](list GoList[T], index int, val T) GoList[T] {
-    len := Len(list)
-    if index < 0 {
-        index = len + index // same as len - abs(index)
-    }
-    if index < 0 || index > len {
+    result, err := TryInsertAt(list, index, val)
+    if err != nil {
         panic("InsertAt, index is out of bound!")
     }
+    return result
+}
 
-    var result GoList[T]
-    if index == len {
-        for node := list.Head; node != nil; node = node.Next {
-            result.appendHead(node.Data)
-        }
-        result.appendHead(val)
-    } else {
-        i := 0
-        for node := list.Head; node != nil; node = node.Next {
-            if i == index {
-                result.appendHead(val)
-            }
-            result.appendHead(node.Data)
-            i++
-        }
-    }
-
-    return *result.reverse()
+// Returns a list of distinct nodes data of list1 that are also in list2, in
+// order of their first occurrence in list1.
+func Intersect[T comparable](list1, list2 GoList[T]) GoList[T] {
+    set2 := toSet(list2)
+    return Uniq(Filter(list1, func(data T) bool {
+        _, found := set2[data]
+        return found
+    }))
 }
 
 // Inserts sep between each node in list. This function has no effect on an
@@ -344,31 +404,21 @@ func Join[T any](list GoList[T], sep T) GoList[T] {
     return *result.reverse()
 }
 
-// Returns the last node in list.
+// Returns the last node in list, which is the Tail of list.
 func Last[T any](list GoList[T]) *node.Node[T] {
-    if list.Head == nil || list.Head.Next == nil {
-        return list.Head
-    }
-    node := list.Head
-    for node.Next != nil {
-        node = node.Next
-    }
-    return node
+    return list.Tail
 }
 
-// Returns the length of list.
+// Returns the length of list, which is the Size of list.
 func Len[T any](list GoList[T]) int {
-    len := 0
-    for node := list.Head; node != nil; node = node.Next {
-        len += 1
-    }
-    return len
+    return list.Size
 }
 
 // Calls fun(data) to every nodes in list and returns a list contains returned
-// values of that fun.
-func Map[T any](list GoList[T], fun func(T) T) GoList[T] {
-    var result GoList[T]
+// values of that fun. Returned values may have a different type from input
+// list data.
+func Map[T1, T2 any](list GoList[T1], fun func(T1) T2) GoList[T2] {
+    var result GoList[T2]
     for node := list.Head; node != nil; node = node.Next {
         result.appendHead(fun(node.Data))
     }
@@ -376,9 +426,10 @@ func Map[T any](list GoList[T], fun func(T) T) GoList[T] {
 }
 
 // Combines the operations of Map function and Foldl function into one pass.
-func MapFoldl[T1, T2 any](list GoList[T1], acc0 T2, fun func(T1, T2) (T1, T2)) (GoList[T1], T2) {
-    var value T1
-    var result GoList[T1]
+// Mapped values may have a different type from input list data.
+func MapFoldl[T1, T2, T3 any](list GoList[T1], acc0 T2, fun func(T1, T2) (T3, T2)) (GoList[T3], T2) {
+    var value T3
+    var result GoList[T3]
     for node := list.Head; node != nil; node = node.Next {
         value, acc0 = fun(node.Data, acc0)
         result.appendHead(value)
@@ -387,9 +438,10 @@ func MapFoldl[T1, T2 any](list GoList[T1], acc0 T2, fun func(T1, T2) (T1, T2)) (
 }
 
 // Combines the operations of Map function and Foldr function into one pass.
-func MapFoldr[T1, T2 any](list GoList[T1], acc0 T2, fun func(T1, T2) (T1, T2)) (GoList[T1], T2) {
-    var value T1
-    var result GoList[T1]
+// Mapped values may have a different type from input list data.
+func MapFoldr[T1, T2, T3 any](list GoList[T1], acc0 T2, fun func(T1, T2) (T3, T2)) (GoList[T3], T2) {
+    var value T3
+    var result GoList[T3]
     reverse := Reverse(list)
     for node := reverse.Head; node != nil; node = node.Next {
         value, acc0 = fun(node.Data, acc0)
@@ -412,21 +464,42 @@ func Max[T constraints.Ordered](list GoList[T]) *node.Node[T] {
     return max
 }
 
-// Returns true if elem matches some node data of list, otherwise retusn false.
-func Member[T any](list GoList[T], elem T) bool {
+// Returns the first node in list that compares greater than or equal to all
+// other nodes of list, using cmp to compare node data. cmp(a, b) should return
+// a negative number when a < b, a positive number when a > b and zero when
+// a == b. Returns nil if list is empty.
+func MaxFunc[T any](list GoList[T], cmp func(a, b T) int) *node.Node[T] {
+    max := list.Head
     for node := list.Head; node != nil; node = node.Next {
-        if cmp.Equal(node.Data, elem) {
-            return true
+        if cmp(node.Data, max.Data) > 0 {
+            max = node
         }
     }
-    return false
+    return max
+}
+
+// Returns true if elem matches some node data of list, otherwise returns false.
+func Member[T comparable](list GoList[T], elem T) bool {
+    return Find(list, elem) != -1
 }
 
-// Returns a sorted list forming by merging all input lists. This function only
-// works with constraint Ordered lists.
+// Returns a sorted list forming by merging all input lists. When all input
+// lists are sorted, they are merged in linear time and nodes that compare
+// equal keep their input order. Otherwise, the result is the same as sorting
+// the concatenation of input lists. This function only works with constraint
+// Ordered lists.
 func Merge[T constraints.Ordered](lists ...GoList[T]) GoList[T] {
-    result := Concat(lists...)
-    return Sort(result)
+    return MergeFunc(compare[T], lists...)
+}
+
+// Returns a sorted list forming by merging all input lists, using cmp to
+// compare node data. When all input lists are sorted, they are merged in
+// linear time. Nodes that compare equal keep their input order.
+func MergeFunc[T any](cmp func(a, b T) int, lists ...GoList[T]) GoList[T] {
+    if !allSortedFunc(lists, cmp) {
+        return SortStableFunc(Concat(lists...), cmp)
+    }
+    return mergeFunc(lists, cmp, false)
 }
 
 // Returns the first node in list that compares less than or equal to all
@@ -443,47 +516,73 @@ func Min[T constraints.Ordered](list GoList[T]) *node.Node[T] {
     return min
 }
 
-// Returns node in list at specific index. index is capped at list length.
-// Negative index indicate an offset from the end of list.
-func Nth[T any](list GoList[T], index int) *node.Node[T] {
-    len := Len(list)
-
-    if index < 0 {
-        index = len + index // same as len - abs(index)
+// Returns the first node in list that compares less than or equal to all
+// other nodes of list, using cmp to compare node data. Returns nil if list is
+// empty.
+func MinFunc[T any](list GoList[T], cmp func(a, b T) int) *node.Node[T] {
+    min := list.Head
+    for node := list.Head; node != nil; node = node.Next {
+        if cmp(node.Data, min.Data) < 0 {
+            min = node
+        }
     }
+    return min
+}
 
-    if index < 0 || index >= len {
+// Returns node in list at specific index. index is capped at list length.
+// Negative index indicate an offset from the end of list. Panics if index is
+// out of bound, see TryNth for a variant returning an error.
+func Nth[T any](list GoList[T], index int) *node.Node[T] {
+    node, err := TryNth(list, index)
+    if err != nil {
         panic("Nth, index is out of bound!")
     }
-
-    node := list.Head
-    for i := 0; i < index; i++ {
-        node = node.Next
-    }
     return node
 }
 
 // Returns sublist from node in list at specific index. index is capped at
-// list length. Negative index indicate an offset from the end of list.
+// list length. Negative index indicate an offset from the end of list. Panics
+// if index is out of bound, see TryNthTail for a variant returning an error.
 func NthTail[T any](list GoList[T], index int) GoList[T] {
-    len := Len(list)
-    if index < 0 {
-        index = len + index // same as len - abs(index)
-    }
-    if index < 0 || index >= len {
+    result, err := TryNthTail(list, index)
+    if err != nil {
         panic("NthTail, index is out of bound!")
     }
+    return result
+}
+
+// Parses str in the format produced by String method, such as ["a"->"b"], into a
+// new list. Each node data is decoded from its text by decode. Returns an
+// error wrapping ErrSyntax if str is not in that format, or the error returned
+// by decode.
+func Parse[T any](str string, decode func(string) (T, error)) (GoList[T], error) {
+    return ParseReader(strings.NewReader(str), decode)
+}
+
+// Parses str into a new list of numbers. Same as Parse, with node data decoded
+// as they are formatted by %v.
+func ParseNumbers[T constraints.Numeric](str string) (GoList[T], error) {
+    return Parse(str, func(token string) (T, error) {
+        return listtext.ParseNumber[T](token, ErrSyntax)
+    })
+}
 
+// Same as Parse, but reading the text from r. The reader is consumed
+// incrementally, so the whole text is never held in memory. Only white
+// spaces may follow the closing bracket.
+func ParseReader[T any](r io.Reader, decode func(string) (T, error)) (GoList[T], error) {
     var result GoList[T]
-    node := list.Head
-    for i := 0; i < index; i++ {
-        node = node.Next
-    }
-    for node != nil {
-        result.appendHead(node.Data)
-        node = node.Next
+    add := func(value T) { result.appendHead(value) }
+    if err := listtext.Read(textFormat, r, decode, add); err != nil {
+        return GoList[T]{}, err
     }
-    return *result.reverse()
+    return *result.reverse(), nil
+}
+
+// Parses str into a new list of strings. Same as Parse, with node data decoded
+// as Go quoted strings, which is how String method formats them.
+func ParseStrings(str string) (GoList[string], error) {
+    return Parse(str, strconv.Unquote)
 }
 
 // Partitions input list into list1 and list2, where list1 contains nodes
@@ -504,11 +603,11 @@ func Partition[T any](list GoList[T], fun func(T) bool) (GoList[T], GoList[T]) {
 // Returns true if list1 is a prefix of list2, otherwise returns false.
 // A prefix of a list is the first part of the list, starting from the
 // beginning and stopping at any point.
-func Prefix[T any](list1, list2 GoList[T]) bool {
+func Prefix[T comparable](list1, list2 GoList[T]) bool {
     node1 := list1.Head
     node2 := list2.Head
     for node1 != nil {
-        if node2 == nil || !cmp.Equal(node1.Data, node2.Data) {
+        if node2 == nil || node1.Data != node2.Data {
             return false
         }
         node1 = node1.Next
@@ -519,35 +618,23 @@ func Prefix[T any](list1, list2 GoList[T]) bool {
 
 // Returns a list that node at specific index is replaced with val. If index
 // is out of bound, the original list is returned. Negative index indicate an
-// offset from the end of list.
+// offset from the end of list. See TryReplaceAt for a variant returning an
+// error.
 func ReplaceAt[T any](list GoList[T], index int, val T) GoList[T] {
-    len := Len(list)
-    if index < 0 {
-        index = len + index // same as len - abs(index)
-    }
-
-    var result GoList[T]
-    i := 0
-    for node := list.Head; node != nil; node = node.Next {
-        if i == index {
-            result.appendHead(val)
-        } else {
-            result.appendHead(node.Data)
-        }
-        i++
+    result, err := TryReplaceAt(list, index, val)
+    if err != nil {
+        return Concat(list)
     }
-
-    return *result.reverse()
+    return result
 }
 
 // Returns a list containing the nodes of input list in reverse order.
 func Reverse[T any](list GoList[T]) GoList[T] {
-    var head *node.Node[T]
-    for curr := list.Head; curr != nil; curr = curr.Next {
-        node := &node.Node[T]{Data: curr.Data, Next: head}
-        head = node
+    var result GoList[T]
+    for node := list.Head; node != nil; node = node.Next {
+        result.appendHead(node.Data)
     }
-    return GoList[T]{Head: head}
+    return result
 }
 
 // Returns position and first node in list that fun returns true. If every fun
@@ -575,37 +662,37 @@ func Seq[T constraints.Numeric](from, to, incr T) GoList[T] {
     return *result.reverse()
 }
 
-// Returns a list containing the sorted nodes data of input list. This function
-// only works with constraint Ordered list.
+// Returns a list containing the sorted nodes data of input list. The sort is
+// a stable O(n log n) merge sort. This function only works with constraint
+// Ordered list.
 func Sort[T constraints.Ordered](list GoList[T]) GoList[T] {
-    return quickSort(list)
+    return SortStableFunc(list, compare[T])
+}
+
+// Returns a list containing the nodes data of input list sorted in ascending
+// order as determined by cmp. cmp(a, b) should return a negative number when
+// a < b, a positive number when a > b and zero when a == b.
+func SortFunc[T any](list GoList[T], cmp func(a, b T) int) GoList[T] {
+    return SortStableFunc(list, cmp)
+}
+
+// Same as SortFunc, but keeping the original order of nodes that compare
+// equal.
+func SortStableFunc[T any](list GoList[T], cmp func(a, b T) int) GoList[T] {
+    result := Concat(list)
+    return *result.mergeSort(cmp)
 }
 
 // Split input list into list1 and list2, list1 contains n first nodes and
 // list2 contains the remaining nodes. n is capped at list length. Negative
-// n indicate an offset from the end of list.
+// n indicate an offset from the end of list. Panics if n is out of bound, see
+// TrySplit for a variant returning an error.
 func Split[T any](list GoList[T], n int) (GoList[T], GoList[T]) {
-    len := Len(list)
-    if n < 0 {
-        n = len + n // same as len - abs(n)
-    }
-    if n < 0 || n >= len {
+    list1, list2, err := TrySplit(list, n)
+    if err != nil {
         panic("Split, n is out of bound!")
     }
-
-    var list1 GoList[T]
-    var list2 GoList[T]
-    node := list.Head
-    for i := 0; i < n; i++ {
-        list1.appendHead(node.Data)
-        node = node.Next
-    }
-    for node != nil {
-        list2.appendHead(node.Data)
-        node = node.Next
-    }
-
-    return *list1.reverse(), *list2.reverse()
+    return list1, list2
 }
 
 // Split input list into list1 and list2, where list1 behave as
@@ -632,51 +719,47 @@ func SplitWith[T any](list GoList[T], fun func(T) bool) (GoList[T], GoList[T]) {
 // Returns sublist of input list, starting at start and has maximum len nodes.
 // start is capped at list length. Negative start indicate an offset from the
 // end of list. len must be a non-negative integer. It is not an error for
-// start + len to exceed the length of list.
+// start + len to exceed the length of list. Panics if start is out of bound
+// or len is negative, see TrySublist for a variant returning an error.
 func Sublist[T any](list GoList[T], start, len int) GoList[T] {
-    if len < 0 {
+    result, err := TrySublist(list, start, len)
+    if err == ErrNegativeLength {
         panic("Sublist, input len must not be negative!")
+    } else if err != nil {
+        panic("Sublist, start is out of bound!")
     }
+    return result
+}
 
-    listLen := Len(list)
-    if start < 0 {
-        start = listLen + start // same as len - abs(start)
-    }
-    if start < 0 || start >= listLen {
-        panic("Sublist, start is out of bound!")
+// Returns a new list that is a copy of list1 which is for each node data in
+// list2, its first occurrence in list1 is deleted. Node data are compared with
+// ==, see SubtractFunc for other cases.
+func Subtract[T comparable](list1, list2 GoList[T]) GoList[T] {
+    counts := make(map[T]int) // number of occurrences to delete
+    for node2 := list2.Head; node2 != nil; node2 = node2.Next {
+        counts[node2.Data]++
     }
 
     var result GoList[T]
-    node := list.Head
-    for i := 0; node != nil && i < start; i++ {
-        node = node.Next
-    }
-    for j := 0; node != nil && j < len; j++ {
-        result.appendHead(node.Data)
-        node = node.Next
+    for node1 := list1.Head; node1 != nil; node1 = node1.Next {
+        if counts[node1.Data] > 0 {
+            counts[node1.Data]--
+            continue
+        }
+        result.appendHead(node1.Data)
     }
     return *result.reverse()
 }
 
 // Returns a new list that is a copy of list1 which is for each node data in
-// list2, its first occurrence in list1 is deleted.
-func Subtract[T any](list1, list2 GoList[T]) GoList[T] {
-    var result GoList[T]
-    if list1.Head == nil {
-        return result
-    }
-    for node1 := list1.Head; node1 != nil; node1 = node1.Next {
-        result.appendHead(node1.Data)
-    }
-    result.reverse()
+// list2, its first occurrence in list1 that eq returns true is deleted.
+func SubtractFunc[T any](list1, list2 GoList[T], eq func(T, T) bool) GoList[T] {
+    result := Concat(list1)
     for node2 := list2.Head; node2 != nil; node2 = node2.Next {
-        if cmp.Equal(result.Head.Data, node2.Data) {
-            result.Head = result.Head.Next
-            continue
-        }
-        for node3 := result.Head; node3.Next != nil; node3 = node3.Next {
-            if cmp.Equal(node3.Next.Data, node2.Data) {
-                node3.Next = node3.Next.Next
+        var prev *node.Node[T]
+        for node3 := result.Head; node3 != nil; prev, node3 = node3, node3.Next {
+            if eq(node3.Data, node2.Data) {
+                result.unlink(prev, node3)
                 break
             }
         }
@@ -687,12 +770,18 @@ func Subtract[T any](list1, list2 GoList[T]) GoList[T] {
 // Returns true if list1 is a suffix of list2, otherwise returns false.
 // A suffix of a list if the last part of the list, starting from any position
 // and going all the way to the end.
-func Suffix[T any](list1, list2 GoList[T]) bool {
+func Suffix[T comparable](list1, list2 GoList[T]) bool {
     reverse1 := Reverse(list1)
     reverse2 := Reverse(list2)
     return Prefix(reverse1, reverse2)
 }
 
+// Returns a list of distinct nodes data that are in exactly one of list1 and
+// list2. Nodes data of list1 come first, each in order of first occurrence.
+func SymmetricDifference[T comparable](list1, list2 GoList[T]) GoList[T] {
+    return Concat(Difference(list1, list2), Difference(list2, list1))
+}
+
 // Returns sum of all nodes data in list. This function only works with
 // constraint Ordered list.
 func Sum[T constraints.Ordered](list GoList[T]) T {
@@ -717,66 +806,531 @@ func TakeWhile[T any](list GoList[T], fun func(T) bool) GoList[T] {
     return *result.reverse()
 }
 
-// Returns a sorted list formed by merging all input lists, while removing
-// duplicates. This function only works with constraint Ordered lists.
-func UMerge[T constraints.Ordered](lists ...GoList[T]) GoList[T] {
-    result := Concat(lists...)
-    return uniqueQuickSort(result)
-}
+// Deletes node at the specific index of list. Negative index indicate an
+// offset from the end of list. Returns ErrEmptyList if list is empty and
+// ErrIndexOutOfRange if index is out of bound.
+func TryDeleteAt[T any](list GoList[T], index int) (GoList[T], error) {
+    index, err := nodeIndex(index, Len(list))
+    if err != nil {
+        return GoList[T]{}, err
+    }
 
-// Returns a sorted list of the nodes data of list, keeping only the first
-// occurrence of nodes that compare equal and removing duplicates. This
-// function only works with constraint Ordered list.
-func USort[T constraints.Ordered](list GoList[T]) GoList[T] {
-    return uniqueQuickSort(list)
+    var result GoList[T]
+    i := 0
+    for node := list.Head; node != nil; node = node.Next {
+        if i != index {
+            result.appendHead(node.Data)
+        }
+        i++
+    }
+    return *result.reverse(), nil
 }
 
-// Returns a list that node at specific index is updated with returns value of
-// fun. If index is out of bound, the original list is returned. Negative index
-// indicate an offset from the end of list.
-func UpdateAt[T any](list GoList[T], index int, fun func(T) T) GoList[T] {
+// Returns a list with val is inserted at specific index. Negative index
+// indicate an offset from the end of list. Returns ErrIndexOutOfRange if index
+// is out of bound.
+func TryInsertAt[T any](list GoList[T], index int, val T) (GoList[T], error) {
     len := Len(list)
     if index < 0 {
         index = len + index // same as len - abs(index)
     }
+    if index < 0 || index > len {
+        return GoList[T]{}, ErrIndexOutOfRange
+    }
 
     var result GoList[T]
     i := 0
     for node := list.Head; node != nil; node = node.Next {
         if i == index {
-            result.appendHead(fun(node.Data))
-        } else {
-            result.appendHead(node.Data)
+            result.appendHead(val)
         }
+        result.appendHead(node.Data)
         i++
     }
-
-    return *result.reverse()
+    if index == len {
+        result.appendHead(val)
+    }
+    return *result.reverse(), nil
 }
 
-/*
- *******************************************************************************
- * Exported methods
+// Returns node in list at specific index. Negative index indicate an offset
+// from the end of list. Returns ErrEmptyList if list is empty and
+// ErrIndexOutOfRange if index is out of bound.
+func TryNth[T any](list GoList[T], index int) (*node.Node[T], error) {
+    index, err := nodeIndex(index, Len(list))
+    if err != nil {
+        return nil, err
+    }
+
+    node := list.Head
+    for i := 0; i < index; i++ {
+        node = node.Next
+    }
+    return node, nil
+}
+
+// Returns sublist from node in list at specific index. Negative index indicate
+// an offset from the end of list. Returns ErrEmptyList if list is empty and
+// ErrIndexOutOfRange if index is out of bound.
+func TryNthTail[T any](list GoList[T], index int) (GoList[T], error) {
+    nth, err := TryNth(list, index)
+    if err != nil {
+        return GoList[T]{}, err
+    }
+
+    var result GoList[T]
+    for node := nth; node != nil; node = node.Next {
+        result.appendHead(node.Data)
+    }
+    return *result.reverse(), nil
+}
+
+// Returns a list that node at specific index is replaced with val. Negative
+// index indicate an offset from the end of list. Returns ErrEmptyList if list
+// is empty and ErrIndexOutOfRange if index is out of bound.
+func TryReplaceAt[T any](list GoList[T], index int, val T) (GoList[T], error) {
+    return TryUpdateAt(list, index, func(T) T { return val })
+}
+
+// Split input list into list1 and list2, list1 contains n first nodes and
+// list2 contains the remaining nodes. Negative n indicate an offset from the
+// end of list. Returns ErrEmptyList if list is empty and ErrIndexOutOfRange if
+// n is out of bound.
+func TrySplit[T any](list GoList[T], n int) (GoList[T], GoList[T], error) {
+    n, err := nodeIndex(n, Len(list))
+    if err != nil {
+        return GoList[T]{}, GoList[T]{}, err
+    }
+
+    var list1 GoList[T]
+    var list2 GoList[T]
+    node := list.Head
+    for i := 0; i < n; i++ {
+        list1.appendHead(node.Data)
+        node = node.Next
+    }
+    for node != nil {
+        list2.appendHead(node.Data)
+        node = node.Next
+    }
+    return *list1.reverse(), *list2.reverse(), nil
+}
+
+// Returns sublist of input list, starting at start and has maximum len nodes.
+// Negative start indicate an offset from the end of list. It is not an error
+// for start + len to exceed the length of list. Returns ErrNegativeLength if
+// len is negative, ErrEmptyList if list is empty and ErrIndexOutOfRange if
+// start is out of bound.
+func TrySublist[T any](list GoList[T], start, len int) (GoList[T], error) {
+    if len < 0 {
+        return GoList[T]{}, ErrNegativeLength
+    }
+    nth, err := TryNth(list, start)
+    if err != nil {
+        return GoList[T]{}, err
+    }
+
+    var result GoList[T]
+    node := nth
+    for j := 0; node != nil && j < len; j++ {
+        result.appendHead(node.Data)
+        node = node.Next
+    }
+    return *result.reverse(), nil
+}
+
+// Returns a list that node at specific index is updated with returns value of
+// fun. Negative index indicate an offset from the end of list. Returns
+// ErrEmptyList if list is empty and ErrIndexOutOfRange if index is out of
+// bound.
+func TryUpdateAt[T any](list GoList[T], index int, fun func(T) T) (GoList[T], error) {
+    index, err := nodeIndex(index, Len(list))
+    if err != nil {
+        return GoList[T]{}, err
+    }
+
+    var result GoList[T]
+    i := 0
+    for node := list.Head; node != nil; node = node.Next {
+        if i == index {
+            result.appendHead(fun(node.Data))
+        } else {
+            result.appendHead(node.Data)
+        }
+        i++
+    }
+    return *result.reverse(), nil
+}
+
+// Returns a list of distinct nodes data of list1 and list2, in order of their
+// first occurrence in list1 and then in list2.
+func Union[T comparable](list1, list2 GoList[T]) GoList[T] {
+    return Uniq(Concat(list1, list2))
+}
+
+// Returns a copy of list keeping only the first occurrence of each node data.
+// Unlike USort, the order of nodes is preserved.
+func Uniq[T comparable](list GoList[T]) GoList[T] {
+    return UniqBy(list, func(data T) T { return data })
+}
+
+// Returns a copy of list keeping only the first node for each key returned by
+// fun. The order of nodes is preserved.
+func UniqBy[T any, K comparable](list GoList[T], fun func(T) K) GoList[T] {
+    var result GoList[T]
+    seen := make(map[K]bool) // store already seen keys into map
+    for node := list.Head; node != nil; node = node.Next {
+        key := fun(node.Data)
+        if seen[key] {
+            continue
+        }
+        seen[key] = true
+        result.appendHead(node.Data)
+    }
+    return *result.reverse()
+}
+
+// Returns a sorted list formed by merging all input lists, while removing
+// duplicates. When all input lists are sorted, they are merged in linear
+// time. This function only works with constraint Ordered lists.
+func UMerge[T constraints.Ordered](lists ...GoList[T]) GoList[T] {
+    return UMergeFunc(compare[T], lists...)
+}
+
+// Returns a sorted list formed by merging all input lists, using cmp to
+// compare node data, while keeping only the first occurrence of nodes that
+// compare equal. When all input lists are sorted, they are merged in linear
+// time.
+func UMergeFunc[T any](cmp func(a, b T) int, lists ...GoList[T]) GoList[T] {
+    if !allSortedFunc(lists, cmp) {
+        return USortFunc(Concat(lists...), cmp)
+    }
+    return mergeFunc(lists, cmp, true)
+}
+
+// Returns a sorted list of the nodes data of list, keeping only the first
+// occurrence of nodes that compare equal and removing duplicates. This
+// function only works with constraint Ordered list.
+func USort[T constraints.Ordered](list GoList[T]) GoList[T] {
+    return USortFunc(list, compare[T])
+}
+
+// Returns a list containing the nodes data of input list sorted as determined
+// by cmp, keeping only the first occurrence of nodes that compare equal.
+func USortFunc[T any](list GoList[T], cmp func(a, b T) int) GoList[T] {
+    var result GoList[T]
+    sorted := SortStableFunc(list, cmp)
+    for node := sorted.Head; node != nil; node = node.Next {
+        if result.Head == nil || cmp(node.Data, result.Head.Data) != 0 {
+            result.appendHead(node.Data)
+        }
+    }
+    return *result.reverse()
+}
+
+// Returns a list that node at specific index is updated with returns value of
+// fun. If index is out of bound, the original list is returned. Negative index
+// indicate an offset from the end of list. See TryUpdateAt for a variant
+// returning an error.
+func UpdateAt[T any](list GoList[T], index int, fun func(T) T) GoList[T] {
+    result, err := TryUpdateAt(list, index, fun)
+    if err != nil {
+        return Concat(list)
+    }
+    return result
+}
+
+// Checks that list is well formed: Next pointers have no cycle, Tail is the
+// last node and Size is the number of nodes. Returns an error wrapping
+// ErrCorrupted that describes the first problem found, or nil.
+func Validate[T any](list GoList[T]) error {
+    if start := cycleStart(list.Head); start != nil {
+        return fmt.Errorf("%w: cycle starts at node %d",
+                          ErrCorrupted, nodePosition(list.Head, start))
+    }
+    count := 0
+    var last *node.Node[T]
+    for node := list.Head; node != nil; node = node.Next {
+        last = node
+        count++
+    }
+    if list.Tail != last {
+        return fmt.Errorf("%w: Tail is not the last node", ErrCorrupted)
+    }
+    if list.Size != count {
+        return fmt.Errorf("%w: Size is %d, but list has %d nodes",
+                          ErrCorrupted, list.Size, count)
+    }
+    return nil
+}
+
+/*
+ *******************************************************************************
+ * Exported methods
  *******************************************************************************
  */
 
+// Returns an iterator over node data of list, from head to tail.
+func (list GoList[T]) All() iter.Seq[T] {
+    return func(yield func(T) bool) {
+        for node := list.Head; node != nil; node = node.Next {
+            if !yield(node.Data) {
+                return
+            }
+        }
+    }
+}
+
+// Returns an iterator over index and node data pairs of list, from head to
+// tail.
+func (list GoList[T]) Enumerate() iter.Seq2[int, T] {
+    return func(yield func(int, T) bool) {
+        i := 0
+        for node := list.Head; node != nil; node = node.Next {
+            if !yield(i, node.Data) {
+                return
+            }
+            i++
+        }
+    }
+}
+
+// Returns an iterator over nodes of list, from head to tail.
+func (list GoList[T]) Nodes() iter.Seq[*node.Node[T]] {
+    return func(yield func(*node.Node[T]) bool) {
+        for node := list.Head; node != nil; node = node.Next {
+            if !yield(node) {
+                return
+            }
+        }
+    }
+}
+
+// Encodes list as a gob stream of its length followed by every node data,
+// from head to tail. Returns the error of Validate if list is corrupted, so a
+// stale Size is never written as the length. Implements
+// encoding.BinaryMarshaler.
+func (list GoList[T]) MarshalBinary() ([]byte, error) {
+    if err := Validate(list); err != nil {
+        return nil, err
+    }
+    var buffer bytes.Buffer
+    encoder := gob.NewEncoder(&buffer)
+    if err := encoder.Encode(Len(list)); err != nil {
+        return nil, err
+    }
+    for node := list.Head; node != nil; node = node.Next {
+        if err := encoder.Encode(node.Data); err != nil {
+            return nil, err
+        }
+    }
+    return buffer.Bytes(), nil
+}
+
+// Decodes data produced by MarshalBinary into list, replacing its nodes.
+// Implements encoding.BinaryUnmarshaler.
+func (list *GoList[T]) UnmarshalBinary(data []byte) error {
+    decoder := gob.NewDecoder(bytes.NewReader(data))
+    var len int
+    if err := decoder.Decode(&len); err != nil {
+        return err
+    }
+    if len < 0 {
+        return fmt.Errorf("golist: invalid encoded length %d", len)
+    }
+
+    var result GoList[T]
+    for i := 0; i < len; i++ {
+        var value T
+        if err := decoder.Decode(&value); err != nil {
+            return err
+        }
+        result.appendHead(value)
+    }
+    *list = *result.reverse()
+    return nil
+}
+
+// Same as MarshalBinary. Implements gob.GobEncoder.
+func (list GoList[T]) GobEncode() ([]byte, error) {
+    return list.MarshalBinary()
+}
+
+// Same as UnmarshalBinary. Implements gob.GobDecoder.
+func (list *GoList[T]) GobDecode(data []byte) error {
+    return list.UnmarshalBinary(data)
+}
+
+// Encodes list as a flat JSON array of node data. An empty list is encoded as
+// an empty array. Implements json.Marshaler.
+func (list GoList[T]) MarshalJSON() ([]byte, error) {
+    values := make([]T, 0, Len(list))
+    for node := list.Head; node != nil; node = node.Next {
+        values = append(values, node.Data)
+    }
+    return json.Marshal(values)
+}
+
+// Decodes a JSON array into list, replacing its nodes. JSON null decodes into
+// an empty list. Implements json.Unmarshaler.
+func (list *GoList[T]) UnmarshalJSON(data []byte) error {
+    var values []T
+    if err := json.Unmarshal(data, &values); err != nil {
+        return err
+    }
+    *list = FromSlice(values)
+    return nil
+}
+
 // Returns a string representing the singly linked list.
 func (list GoList[T]) String() string {
     var builder strings.Builder
-    builder.WriteString("[")
+    list.WriteTo(&builder)
+    return builder.String()
+}
+
+// Writes the same text as String method into w, node by node, without
+// building the whole string in memory. Returns the number of bytes written.
+// Implements io.WriterTo.
+func (list GoList[T]) WriteTo(w io.Writer) (int64, error) {
+    return listtext.Write(textFormat, w, list.All())
+}
+
+// Implements fmt.Formatter. Verbs %v and %s format the list same as String
+// method, %+v also prints the index of each node, such as [0:"a"->1:"b"], and
+// %#v prints the list in Go syntax, such as golist.New(1, 2, 3). Precision
+// limits the number of printed nodes, so %.10v prints only the first 10 nodes
+// followed by an ellipsis and the total number of nodes. Other verbs, flags
+// and width are applied to each node data.
+func (list GoList[T]) Format(state fmt.State, verb rune) {
+    if verb == 's' {
+        verb = 'v'
+    }
+    limit, truncate := state.Precision()
+    goSyntax := verb == 'v' && state.Flag('#')
+    indexed := verb == 'v' && state.Flag('+')
+    format, quoted := dataFormat(state, verb), dataFormat(state, 'q')
+
+    if goSyntax && list.Head == nil {
+        fmt.Fprintf(state, "golist.New[%v]()", reflect.TypeFor[T]())
+        return
+    } else if goSyntax {
+        io.WriteString(state, "golist.New(")
+    } else {
+        io.WriteString(state, "[")
+    }
+    index := 0
     for node := list.Head; node != nil; node = node.Next {
+        if truncate && index == limit {
+            if goSyntax {
+                fmt.Fprintf(state, "... /* %d total */", Len(list))
+            } else {
+                fmt.Fprintf(state, "...(%d total)", Len(list))
+            }
+            break
+        }
+        if indexed {
+            fmt.Fprintf(state, "%d:", index)
+        }
         var data any = node.Data
-        if str, ok := data.(string); ok {
-            fmt.Fprintf(&builder, "%q", str)
+        if _, ok := data.(string); ok && verb == 'v' && !goSyntax {
+            fmt.Fprintf(state, quoted, data)
         } else {
-            fmt.Fprintf(&builder, "%v", node.Data)
+            fmt.Fprintf(state, format, data)
         }
-        if node.Next != nil {
-            builder.WriteString("->")
+        if node.Next != nil && goSyntax {
+            io.WriteString(state, ", ")
+        } else if node.Next != nil {
+            io.WriteString(state, "->")
         }
+        index++
+    }
+    if goSyntax {
+        io.WriteString(state, ")")
+    } else {
+        io.WriteString(state, "]")
+    }
+}
+
+/*
+ *******************************************************************************
+ * Exported in-place methods
+ *
+ * Unlike the functions above, which always return a new list and leave their
+ * input untouched, these methods modify the list they are called on.
+ *******************************************************************************
+ */
+
+// Inserts value at head of list and returns the new node. O(1).
+func (list *GoList[T]) PushFront(value T) *node.Node[T] {
+    list.appendHead(value)
+    return list.Head
+}
+
+// Inserts value at end of list and returns the new node. O(1).
+func (list *GoList[T]) PushBack(value T) *node.Node[T] {
+    if list.Tail == nil {
+        return list.PushFront(value)
+    }
+    return list.InsertAfter(list.Tail, value)
+}
+
+// Removes the first node of list and returns its data. Returns false if list
+// is empty. O(1).
+func (list *GoList[T]) PopFront() (T, bool) {
+    var zero T
+    if list.Head == nil {
+        return zero, false
+    }
+    node := list.Head
+    list.unlink(nil, node)
+    return node.Data, true
+}
+
+// Removes the last node of list and returns its data. Returns false if list
+// is empty. O(n), because the node before the last node must be found first.
+func (list *GoList[T]) PopBack() (T, bool) {
+    var zero T
+    if list.Head == nil {
+        return zero, false
+    }
+    node := list.Tail
+    prev, _ := list.prev(node)
+    list.unlink(prev, node)
+    return node.Data, true
+}
+
+// Inserts value right after mark and returns the new node. mark must be a node
+// of list. O(1).
+func (list *GoList[T]) InsertAfter(mark *node.Node[T], value T) *node.Node[T] {
+    node := &node.Node[T]{Data: value, Next: mark.Next}
+    mark.Next = node
+    if list.Tail == mark {
+        list.Tail = node
+    }
+    list.Size++
+    return node
+}
+
+// Removes node from list and returns its data. If node is not a node of list,
+// list is left unchanged. O(n), because the node before node must be found
+// first.
+func (list *GoList[T]) Remove(node *node.Node[T]) T {
+    if prev, found := list.prev(node); found {
+        list.unlink(prev, node)
+    }
+    return node.Data
+}
+
+// Moves node to head of list. If node is not a node of list, list is left
+// unchanged. O(n), because the node before node must be found first.
+func (list *GoList[T]) MoveToFront(node *node.Node[T]) {
+    if prev, found := list.prev(node); found && prev != nil {
+        list.unlink(prev, node)
+        node.Next = list.Head
+        list.Head = node
+        list.Size++
     }
-    builder.WriteString("]")
-    return builder.String()
 }
 
 /*
@@ -785,13 +1339,58 @@ func (list GoList[T]) String() string {
  *******************************************************************************
  */
 
+// Returns a set of all nodes data of list.
+func toSet[T comparable](list GoList[T]) map[T]struct{} {
+    set := make(map[T]struct{})
+    for node := list.Head; node != nil; node = node.Next {
+        set[node.Data] = struct{}{}
+    }
+    return set
+}
+
 // Do append value into head of list.
 func (list *GoList[T]) appendHead(value T) *GoList[T] {
     node := &node.Node[T]{Data: value, Next: list.Head}
+    if list.Head == nil {
+        list.Tail = node
+    }
     list.Head = node
+    list.Size++
     return list
 }
 
+// Returns the node before node in list, nil if node is the head of list. The
+// second return value is false if node is not a node of list.
+func (list *GoList[T]) prev(node *node.Node[T]) (*node.Node[T], bool) {
+    if node == nil {
+        return nil, false
+    }
+    if list.Head == node {
+        return nil, true
+    }
+    for prev := list.Head; prev != nil; prev = prev.Next {
+        if prev.Next == node {
+            return prev, true
+        }
+    }
+    return nil, false
+}
+
+// Do unlink node from list, where prev is the node before node or nil if node
+// is the head of list.
+func (list *GoList[T]) unlink(prev, node *node.Node[T]) {
+    if prev == nil {
+        list.Head = node.Next
+    } else {
+        prev.Next = node.Next
+    }
+    if list.Tail == node {
+        list.Tail = prev
+    }
+    node.Next = nil
+    list.Size--
+}
+
 // Do reverse the list.
 func (list *GoList[T]) reverse() *GoList[T] {
     var prev *node.Node[T]
@@ -802,74 +1401,210 @@ func (list *GoList[T]) reverse() *GoList[T] {
         prev = node
         node = next
     }
-    list.Head = prev
+    list.Head, list.Tail = prev, list.Head
     return list
 }
 
-// Do quick sort input list.
-func quickSort[T constraints.Ordered](list GoList[T]) GoList[T] {
-    if list.Head == nil || list.Head.Next == nil {
-        return list
+// Returns index as an offset from the head of a list of length len, or an
+// error if index does not address a node of that list. Negative index
+// indicate an offset from the end of list.
+func nodeIndex(index, len int) (int, error) {
+    if len == 0 {
+        return 0, ErrEmptyList
     }
+    if index < 0 {
+        index = len + index // same as len - abs(index)
+    }
+    if index < 0 || index >= len {
+        return 0, ErrIndexOutOfRange
+    }
+    return index, nil
+}
 
-    pivot := list.Head.Data
-    var less, equal, greater GoList[T]
+// Returns true if nodes data of every input list are in ascending order as
+// determined by cmp.
+func allSortedFunc[T any](lists []GoList[T], cmp func(a, b T) int) bool {
+    for _, list := range lists {
+        for node := list.Head; node != nil && node.Next != nil; node = node.Next {
+            if cmp(node.Next.Data, node.Data) < 0 {
+                return false
+            }
+        }
+    }
+    return true
+}
 
-    // Partitioning
-    for node := list.Head; node != nil; node = node.Next {
-        switch {
-        case node.Data < pivot:
-            less.appendHead(node.Data)
-        case node.Data == pivot:
-            equal.appendHead(node.Data)
-        case node.Data > pivot:
-            greater.appendHead(node.Data)
+// Do k-way merge sorted input lists, using a heap of the current node of each
+// list. If unique is true, nodes that compare equal to the previous merged
+// node are skipped.
+func mergeFunc[T any](lists []GoList[T], cmp func(a, b T) int, unique bool) GoList[T] {
+    h := &mergeHeap[T]{cmp: cmp}
+    for i, list := range lists {
+        if list.Head != nil {
+            h.items = append(h.items, mergeItem[T]{node: list.Head, list: i})
         }
     }
+    heap.Init(h)
 
-    // Recursive sort
-    sortedLess := quickSort(less)
-    sortedGreater := quickSort(greater)
+    var result GoList[T]
+    for h.Len() > 0 {
+        item := &h.items[0]
+        if !unique || result.Head == nil || cmp(item.node.Data, result.Head.Data) != 0 {
+            result.appendHead(item.node.Data)
+        }
+        if item.node = item.node.Next; item.node != nil {
+            heap.Fix(h, 0)
+        } else {
+            heap.Pop(h)
+        }
+    }
+    return *result.reverse()
+}
+
+// Current node of an input list of mergeFunc, and position of that list.
+type mergeItem[T any] struct {
+    node *node.Node[T]
+    list int
+}
 
-    // Concatenates 3 lists: sortedLess + equal + sortedGreater
-    result := Concat(sortedLess, equal, sortedGreater)
+// Min-heap of mergeItem, implementing heap.Interface. Items are ordered by
+// node data, then by list position so that merging is stable.
+type mergeHeap[T any] struct {
+    items []mergeItem[T]
+    cmp   func(a, b T) int
+}
 
-    return result
+func (h *mergeHeap[T]) Len() int {
+    return len(h.items)
 }
 
-// Do quick sort input list and remove duplicate nodes.
-func uniqueQuickSort[T constraints.Ordered](list GoList[T]) GoList[T] {
-    if list.Head == nil || list.Head.Next == nil {
-        return list
+func (h *mergeHeap[T]) Less(i, j int) bool {
+    c := h.cmp(h.items[i].node.Data, h.items[j].node.Data)
+    return c < 0 || c == 0 && h.items[i].list < h.items[j].list
+}
+
+func (h *mergeHeap[T]) Swap(i, j int) {
+    h.items[i], h.items[j] = h.items[j], h.items[i]
+}
+
+func (h *mergeHeap[T]) Push(x any) {
+    h.items = append(h.items, x.(mergeItem[T]))
+}
+
+func (h *mergeHeap[T]) Pop() any {
+    last := h.items[len(h.items)-1]
+    h.items = h.items[:len(h.items)-1]
+    return last
+}
+
+// Returns -1, 0 or 1 if a is less than, equal to or greater than b.
+func compare[T constraints.Ordered](a, b T) int {
+    switch {
+    case a < b:
+        return -1
+    case a > b:
+        return 1
     }
+    return 0
+}
 
-    pivot := list.Head.Data
-    var less, equal, greater GoList[T]
-    seen := make(map[T]bool) // store already seen node data into map
+// Do stable bottom-up merge sort the nodes of list in place, using cmp to
+// compare node data. Runs of width 1, 2, 4, ... are merged pairwise until one
+// run covers the whole list, so no recursion is needed.
+func (list *GoList[T]) mergeSort(cmp func(a, b T) int) *GoList[T] {
+    if list.Size < 2 {
+        return list
+    }
 
-    // Partitioning and remove seen node
-    for node := list.Head; node != nil; node = node.Next {
-        if seen[node.Data] {
-            continue
+    for width := 1; width < list.Size; width *= 2 {
+        var dummy node.Node[T]
+        tail := &dummy
+        for curr := list.Head; curr != nil; {
+            left := curr
+            right := cutNodes(left, width)
+            curr = cutNodes(right, width)
+            tail = mergeNodes(tail, left, right, cmp)
         }
-        seen[node.Data] = true
+        list.Head = dummy.Next
+        list.Tail = tail
+    }
+    return list
+}
 
-        switch {
-        case node.Data < pivot:
-            less.appendHead(node.Data)
-        case node.Data == pivot:
-            equal.appendHead(node.Data)
-        case node.Data > pivot:
-            greater.appendHead(node.Data)
+// Cuts the chain of nodes starting at head after n nodes, and returns the
+// first node of the remaining chain.
+func cutNodes[T any](head *node.Node[T], n int) *node.Node[T] {
+    for i := 1; head != nil && i < n; i++ {
+        head = head.Next
+    }
+    if head == nil {
+        return nil
+    }
+    rest := head.Next
+    head.Next = nil
+    return rest
+}
+
+// Links the nodes of sorted chains left and right after tail in sorted order,
+// and returns the last linked node. Nodes of left come first when equal.
+func mergeNodes[T any](tail, left, right *node.Node[T], cmp func(a, b T) int) *node.Node[T] {
+    for left != nil && right != nil {
+        if cmp(right.Data, left.Data) < 0 {
+            tail.Next = right
+            right = right.Next
+        } else {
+            tail.Next = left
+            left = left.Next
         }
+        tail = tail.Next
+    }
+    if left != nil {
+        tail.Next = left
+    } else {
+        tail.Next = right
     }
+    for tail.Next != nil {
+        tail = tail.Next
+    }
+    return tail
+}
 
-    // Recursive sort
-    sortedLess := uniqueQuickSort(less)
-    sortedGreater := uniqueQuickSort(greater)
+// Returns the format applying verb, flags and width of state to node data.
+// Flag + is dropped for %q, which would escape non-ASCII characters, so
+// strings are quoted same as String method.
+func dataFormat(state fmt.State, verb rune) string {
+    format := "%"
+    for _, flag := range "+-# 0" {
+        if state.Flag(int(flag)) && !(flag == '+' && verb == 'q') {
+            format += string(flag)
+        }
+    }
+    if width, ok := state.Width(); ok {
+        format += strconv.Itoa(width)
+    }
+    return format + string(verb)
+}
 
-    // Concatenate: sortedLess + equal + sortedGreater
-    result := Concat(sortedLess, equal, sortedGreater)
+// Returns the node where the cycle reached from head starts, or nil if Next
+// pointers from head end with nil.
+func cycleStart[T any](head *node.Node[T]) *node.Node[T] {
+    slow, fast := head, head
+    for fast != nil && fast.Next != nil {
+        slow, fast = slow.Next, fast.Next.Next
+        if slow == fast {
+            for slow = head; slow != fast; slow, fast = slow.Next, fast.Next {
+            }
+            return slow
+        }
+    }
+    return nil
+}
 
-    return result
+// Returns index of target in the nodes reached from head.
+func nodePosition[T any](head, target *node.Node[T]) int {
+    index := 0
+    for node := head; node != target; node = node.Next {
+        index++
+    }
+    return index
 }
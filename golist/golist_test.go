@@ -2,7 +2,18 @@ package golist
 
 import (
     "testing"
+    "bytes"
+    "encoding/gob"
+    "encoding/json"
+    "errors"
     "reflect"
+    "strings"
+    "fmt"
+    "slices"
+    "strconv"
+    "maps"
+    "math/rand"
+    "github.com/hiennguyen-neih/go-linkedlist/node"
 )
 
 func TestNew_ToSlice(t *testing.T) {
@@ -627,3 +638,838 @@ func TestUSort(t *testing.T) {
         t.Errorf("USort\nresult: %v\nexpected: %v", result, expected)
     }
 }
+
+func TestFromSeq_Collect(t *testing.T) {
+    list1 := FromSeq(slices.Values([]int{1, 2, 3, 4}))
+    list2 := Collect(maps.Keys(map[string]int{"a": 1}))
+    expected1 := []int{1, 2, 3, 4}
+    expected2 := []string{"a"}
+    if result := ToSlice(list1); !reflect.DeepEqual(result, expected1) {
+        t.Errorf("FromSeq\nresult: %v\nexpected: %v", result, expected1)
+    }
+    if result := ToSlice(list2); !reflect.DeepEqual(result, expected2) {
+        t.Errorf("Collect\nresult: %v\nexpected: %v", result, expected2)
+    }
+}
+
+func TestGoListAll(t *testing.T) {
+    list := New(1, 2, 3, 4)
+    expected := []int{1, 2, 3, 4}
+    if result := slices.Collect(list.All()); !reflect.DeepEqual(result, expected) {
+        t.Errorf("All\nresult: %v\nexpected: %v", result, expected)
+    }
+
+    var result []int
+    for val := range list.All() {
+        if val == 3 {
+            break
+        }
+        result = append(result, val)
+    }
+    if expected := []int{1, 2}; !reflect.DeepEqual(result, expected) {
+        t.Errorf("All\nresult: %v\nexpected: %v", result, expected)
+    }
+}
+
+func TestGoListEnumerate(t *testing.T) {
+    list := New("a", "b", "c")
+    result := map[int]string{}
+    for i, val := range list.Enumerate() {
+        result[i] = val
+    }
+    expected := map[int]string{0: "a", 1: "b", 2: "c"}
+    if !reflect.DeepEqual(result, expected) {
+        t.Errorf("Enumerate\nresult: %v\nexpected: %v", result, expected)
+    }
+}
+
+func TestGoListNodes(t *testing.T) {
+    list := New(1, 2, 3)
+    for node := range list.Nodes() {
+        node.Data *= 10
+    }
+    expected := []int{10, 20, 30}
+    if result := ToSlice(list); !reflect.DeepEqual(result, expected) {
+        t.Errorf("Nodes\nresult: %v\nexpected: %v", result, expected)
+    }
+}
+
+func TestMap_ChangeType(t *testing.T) {
+    list := New("a", "bb", "ccc")
+    mapped := Map(list, func(s string) int { return len(s) })
+    expected := []int{1, 2, 3}
+    if result := ToSlice(mapped); !reflect.DeepEqual(result, expected) {
+        t.Errorf("Map\nresult: %v\nexpected: %v", result, expected)
+    }
+}
+
+func TestFilterMap_ChangeType(t *testing.T) {
+    list := New(1, 2, 3, 4)
+    filtered := FilterMap(list, func(n int) (bool, string) {
+        return n%2 == 0, strconv.Itoa(n * n)
+    })
+    expected := []string{"4", "16"}
+    if result := ToSlice(filtered); !reflect.DeepEqual(result, expected) {
+        t.Errorf("FilterMap\nresult: %v\nexpected: %v", result, expected)
+    }
+}
+
+func TestMapFoldl_MapFoldr_ChangeType(t *testing.T) {
+    list := New(1, 2, 3)
+    mapped1, sum := MapFoldl(list, 0, func(n, s int) (string, int) {
+        return strconv.Itoa(s + n), s + n
+    })
+    mapped2, fac := MapFoldr(list, 1, func(n, f int) (string, int) {
+        return strconv.Itoa(f * n), f * n
+    })
+    expected1 := []string{"1", "3", "6"}
+    expected2 := []string{"6", "6", "3"}
+    if result := ToSlice(mapped1); !reflect.DeepEqual(result, expected1) || sum != 6 {
+        t.Errorf("MapFoldl\nresult: %v - %v\nexpected: %v - %v", sum, result, 6, expected1)
+    }
+    if result := ToSlice(mapped2); !reflect.DeepEqual(result, expected2) || fac != 6 {
+        t.Errorf("MapFoldr\nresult: %v - %v\nexpected: %v - %v", fac, result, 6, expected2)
+    }
+}
+
+func TestFlatMap(t *testing.T) {
+    list := New(1, 2, 3)
+    flatMapped := FlatMap(list, func(n int) GoList[string] {
+        return Duplicate(n, strconv.Itoa(n))
+    })
+    expected := []string{"1", "2", "2", "3", "3", "3"}
+    if result := ToSlice(flatMapped); !reflect.DeepEqual(result, expected) {
+        t.Errorf("FlatMap\nresult: %v\nexpected: %v", result, expected)
+    }
+}
+
+func TestGoListPushFront_PushBack(t *testing.T) {
+    var list GoList[int]
+    list.PushBack(2)
+    list.PushFront(1)
+    list.PushBack(3)
+    expected := []int{1, 2, 3}
+    if result := ToSlice(list); !reflect.DeepEqual(result, expected) {
+        t.Errorf("PushFront, PushBack\nresult: %v\nexpected: %v", result, expected)
+    }
+}
+
+func TestGoListPopFront_PopBack(t *testing.T) {
+    list := New(1, 2, 3)
+    front, ok1 := list.PopFront()
+    back, ok2 := list.PopBack()
+    if front != 1 || back != 3 || !ok1 || !ok2 {
+        t.Errorf("PopFront, PopBack\nresult: %v - %v\nexpected: 1 - 3", front, back)
+    }
+    if result := ToSlice(list); !reflect.DeepEqual(result, []int{2}) {
+        t.Errorf("PopFront, PopBack\nresult: %v\nexpected: [2]", result)
+    }
+
+    list.PopBack()
+    if _, ok := list.PopFront(); ok || list.Head != nil {
+        t.Errorf("PopFront\nexpected false on empty list")
+    }
+    if _, ok := list.PopBack(); ok {
+        t.Errorf("PopBack\nexpected false on empty list")
+    }
+}
+
+func TestGoListInsertAfter_Remove(t *testing.T) {
+    list := New("a", "c")
+    node := list.InsertAfter(list.Head, "b")
+    list.InsertAfter(node.Next, "d")
+    expected1 := []string{"a", "b", "c", "d"}
+    if result := ToSlice(list); !reflect.DeepEqual(result, expected1) {
+        t.Errorf("InsertAfter\nresult: %v\nexpected: %v", result, expected1)
+    }
+
+    removed := list.Remove(node)
+    list.Remove(list.Head)
+    expected2 := []string{"c", "d"}
+    if result := ToSlice(list); removed != "b" || !reflect.DeepEqual(result, expected2) {
+        t.Errorf("Remove\nresult: %v - %v\nexpected: b - %v", removed, result, expected2)
+    }
+}
+
+func TestGoListMoveToFront(t *testing.T) {
+    list := New(1, 2, 3, 4)
+    list.MoveToFront(Nth(list, 2))
+    list.MoveToFront(Last(list))
+    list.MoveToFront(list.Head)
+    expected := []int{4, 3, 1, 2}
+    if result := ToSlice(list); !reflect.DeepEqual(result, expected) {
+        t.Errorf("MoveToFront\nresult: %v\nexpected: %v", result, expected)
+    }
+}
+
+// Checks that Tail and Size of list match its nodes.
+func checkInvariants[T any](t *testing.T, name string, list GoList[T]) {
+    t.Helper()
+    size := 0
+    var prev *node.Node[T]
+    for node := list.Head; node != nil; node = node.Next {
+        prev = node
+        size++
+    }
+    if list.Tail != prev {
+        t.Errorf("%v\nTail: %v\nexpected: %v", name, list.Tail, prev)
+    }
+    if list.Size != size {
+        t.Errorf("%v\nSize: %v\nexpected: %v", name, list.Size, size)
+    }
+}
+
+func TestTailSize_Functions(t *testing.T) {
+    list := New(5, 1, 4, 2, 3, 2)
+    isOdd := func(n int) bool { return n%2 != 0 }
+    square := func(n int) int { return n * n }
+    results := map[string]GoList[int]{
+        "New":        list,
+        "FromSlice":  FromSlice([]int{1, 2}),
+        "Append":     Append(list, 7),
+        "AppendHead": AppendHead(list, 7),
+        "Concat":     Concat(list, list),
+        "Delete":     Delete(list, 3),
+        "DeleteAt":   DeleteAt(list, -1),
+        "DropLast":   DropLast(list),
+        "DropLast1":  DropLast(New(1)),
+        "DropWhile":  DropWhile(list, isOdd),
+        "Duplicate":  Duplicate(3, 0),
+        "Filter":     Filter(list, isOdd),
+        "InsertAt":   InsertAt(list, -1, 7),
+        "Join":       Join(list, 0),
+        "Map":        Map(list, square),
+        "Merge":      Merge(list, list),
+        "NthTail":    NthTail(list, -2),
+        "ReplaceAt":  ReplaceAt(list, 0, 7),
+        "Reverse":    Reverse(list),
+        "Seq":        Seq(1, 9, 2),
+        "Sort":       Sort(list),
+        "Sublist":    Sublist(list, 1, 3),
+        "Subtract":   Subtract(list, New(3, 2, 5)),
+        "Subtract1":  Subtract(New(1), New(1)),
+        "TakeWhile":  TakeWhile(list, isOdd),
+        "UMerge":     UMerge(list, list),
+        "USort":      USort(list),
+        "UpdateAt":   UpdateAt(list, -1, square),
+    }
+    results["MapFoldl"], _ = MapFoldl(list, 0, func(n, s int) (int, int) { return n, s })
+    results["MapFoldr"], _ = MapFoldr(list, 0, func(n, s int) (int, int) { return n, s })
+    results["Partition1"], results["Partition2"] = Partition(list, isOdd)
+    results["Split1"], results["Split2"] = Split(list, 2)
+    results["SplitWith1"], results["SplitWith2"] = SplitWith(list, isOdd)
+    for name, result := range results {
+        checkInvariants(t, name, result)
+    }
+}
+
+func TestTailSize_Methods(t *testing.T) {
+    var list GoList[int]
+    list.PushBack(2)
+    checkInvariants(t, "PushBack", list)
+    list.PushFront(1)
+    checkInvariants(t, "PushFront", list)
+    node := list.InsertAfter(list.Tail, 4)
+    checkInvariants(t, "InsertAfter", list)
+    list.InsertAfter(list.Head.Next, 3)
+    checkInvariants(t, "InsertAfter", list)
+    list.MoveToFront(node)
+    checkInvariants(t, "MoveToFront", list)
+    list.MoveToFront(list.Tail)
+    checkInvariants(t, "MoveToFront", list)
+    list.Remove(list.Tail)
+    checkInvariants(t, "Remove", list)
+    list.PopBack()
+    checkInvariants(t, "PopBack", list)
+    list.PopFront()
+    checkInvariants(t, "PopFront", list)
+    list.PopFront()
+    checkInvariants(t, "PopFront", list)
+    if Len(list) != 0 || Last(list) != nil {
+        t.Errorf("PopFront\nresult: %v\nexpected: []", list)
+    }
+}
+
+func TestLen_Last(t *testing.T) {
+    list := Append(New(1, 2, 3), 4)
+    if Len(list) != 4 || Last(list) != Nth(list, -1) || Last(list).Data != 4 {
+        t.Errorf("Len, Last\nresult: %v - %v\nexpected: 4 - 4", Len(list), Last(list))
+    }
+}
+
+func TestTryNth(t *testing.T) {
+    list := New(1, 2, 3)
+    node, err := TryNth(list, -1)
+    if err != nil || node.Data != 3 {
+        t.Errorf("TryNth\nresult: %v - %v\nexpected: 3 - nil", node, err)
+    }
+    if _, err := TryNth(New[int](), 0); !errors.Is(err, ErrEmptyList) {
+        t.Errorf("TryNth\nresult: %v\nexpected: %v", err, ErrEmptyList)
+    }
+    if _, err := TryNth(list, 3); !errors.Is(err, ErrIndexOutOfRange) {
+        t.Errorf("TryNth\nresult: %v\nexpected: %v", err, ErrIndexOutOfRange)
+    }
+}
+
+func TestTryAt_IndexOutOfRange(t *testing.T) {
+    list := New("a", "b", "c")
+    _, err1 := TryDeleteAt(list, 3)
+    _, err2 := TryInsertAt(list, -4, "x")
+    _, err3 := TryNthTail(list, -4)
+    _, err4 := TryReplaceAt(list, 5, "x")
+    _, _, err5 := TrySplit(list, 3)
+    _, err6 := TrySublist(list, 3, 1)
+    _, err7 := TryUpdateAt(list, -5, strings.ToUpper)
+    for i, err := range []error{err1, err2, err3, err4, err5, err6, err7} {
+        if !errors.Is(err, ErrIndexOutOfRange) {
+            t.Errorf("Try #%v\nresult: %v\nexpected: %v", i+1, err, ErrIndexOutOfRange)
+        }
+    }
+}
+
+func TestTryAt_EmptyList(t *testing.T) {
+    var list GoList[string]
+    _, err1 := TryDeleteAt(list, 0)
+    _, err2 := TryNthTail(list, 0)
+    _, err3 := TryReplaceAt(list, 0, "x")
+    _, _, err4 := TrySplit(list, 0)
+    _, err5 := TrySublist(list, 0, 1)
+    _, err6 := TryUpdateAt(list, 0, strings.ToUpper)
+    for i, err := range []error{err1, err2, err3, err4, err5, err6} {
+        if !errors.Is(err, ErrEmptyList) {
+            t.Errorf("Try #%v\nresult: %v\nexpected: %v", i+1, err, ErrEmptyList)
+        }
+    }
+    if _, err := TrySublist(New("a"), 0, -1); !errors.Is(err, ErrNegativeLength) {
+        t.Errorf("TrySublist\nresult: %v\nexpected: %v", err, ErrNegativeLength)
+    }
+}
+
+func TestTryAt_NormalCase(t *testing.T) {
+    list := New("a", "b", "c")
+    deleted, err1 := TryDeleteAt(list, 1)
+    inserted, err2 := TryInsertAt(list, 3, "d")
+    tail, err3 := TryNthTail(list, 1)
+    replaced, err4 := TryReplaceAt(list, 0, "x")
+    list1, list2, err5 := TrySplit(list, 1)
+    sublist, err6 := TrySublist(list, -2, 5)
+    updated, err7 := TryUpdateAt(list, -1, strings.ToUpper)
+    for i, err := range []error{err1, err2, err3, err4, err5, err6, err7} {
+        if err != nil {
+            t.Errorf("Try #%v\nresult: %v\nexpected: nil", i+1, err)
+        }
+    }
+    results := [][]string{
+        ToSlice(deleted), ToSlice(inserted), ToSlice(tail), ToSlice(replaced),
+        ToSlice(list1), ToSlice(list2), ToSlice(sublist), ToSlice(updated),
+    }
+    expected := [][]string{
+        {"a", "c"}, {"a", "b", "c", "d"}, {"b", "c"}, {"x", "b", "c"},
+        {"a"}, {"b", "c"}, {"b", "c"}, {"a", "b", "C"},
+    }
+    if !reflect.DeepEqual(results, expected) {
+        t.Errorf("Try\nresult: %v\nexpected: %v", results, expected)
+    }
+}
+
+type person struct {
+    name string
+    age  int
+}
+
+func byAge(a, b person) int {
+    return a.age - b.age
+}
+
+func TestSortFunc_SortStableFunc(t *testing.T) {
+    list := New(person{"a", 30}, person{"b", 20}, person{"c", 30}, person{"d", 10})
+    sorted1 := SortFunc(list, byAge)
+    sorted2 := SortStableFunc(list, byAge)
+    expected := []person{{"d", 10}, {"b", 20}, {"a", 30}, {"c", 30}}
+    if result := ToSlice(sorted1); !reflect.DeepEqual(result, expected) {
+        t.Errorf("SortFunc\nresult: %v\nexpected: %v", result, expected)
+    }
+    if result := ToSlice(sorted2); !reflect.DeepEqual(result, expected) {
+        t.Errorf("SortStableFunc\nresult: %v\nexpected: %v", result, expected)
+    }
+}
+
+func TestUSortFunc(t *testing.T) {
+    list := New(person{"a", 30}, person{"b", 20}, person{"c", 30}, person{"d", 20})
+    sorted := USortFunc(list, byAge)
+    expected := []person{{"b", 20}, {"a", 30}}
+    if result := ToSlice(sorted); !reflect.DeepEqual(result, expected) {
+        t.Errorf("USortFunc\nresult: %v\nexpected: %v", result, expected)
+    }
+}
+
+func TestMergeFunc(t *testing.T) {
+    list1 := New(person{"a", 10}, person{"b", 30})
+    list2 := New(person{"c", 20}, person{"d", 30})
+    merged := MergeFunc(byAge, list1, list2)
+    expected := []person{{"a", 10}, {"c", 20}, {"b", 30}, {"d", 30}}
+    if result := ToSlice(merged); !reflect.DeepEqual(result, expected) {
+        t.Errorf("MergeFunc\nresult: %v\nexpected: %v", result, expected)
+    }
+}
+
+func TestMaxFunc_MinFunc(t *testing.T) {
+    list := New(person{"a", 20}, person{"b", 30}, person{"c", 10}, person{"d", 30}, person{"e", 10})
+    max := MaxFunc(list, byAge)
+    min := MinFunc(list, byAge)
+    if max.Data.name != "b" {
+        t.Errorf("MaxFunc\nresult: %v\nexpected: {b 30}", max)
+    }
+    if min.Data.name != "c" {
+        t.Errorf("MinFunc\nresult: %v\nexpected: {c 10}", min)
+    }
+    if MaxFunc(New[person](), byAge) != nil || MinFunc(New[person](), byAge) != nil {
+        t.Errorf("MaxFunc, MinFunc\nexpected nil for empty list")
+    }
+}
+
+func TestSort(t *testing.T) {
+    list := New(5, 3, 8, 1, 9, 2, 7, 3, 6, 4, 0)
+    sorted := Sort(list)
+    expected := []int{0, 1, 2, 3, 3, 4, 5, 6, 7, 8, 9}
+    if result := ToSlice(sorted); !reflect.DeepEqual(result, expected) {
+        t.Errorf("Sort\nresult: %v\nexpected: %v", result, expected)
+    }
+    checkInvariants(t, "Sort", sorted)
+    if result := ToSlice(list); reflect.DeepEqual(result, expected) {
+        t.Errorf("Sort\ninput list is modified: %v", result)
+    }
+}
+
+func TestSort_Large(t *testing.T) {
+    const n = 100000
+    lists := map[string]GoList[int]{
+        "sorted":  Seq(0, n-1, 1),
+        "reverse": Reverse(Seq(0, n-1, 1)),
+        "random":  FromSlice(rand.New(rand.NewSource(1)).Perm(n)),
+    }
+    for name, list := range lists {
+        sorted := Sort(list)
+        if result := ToSlice(sorted); !slices.IsSorted(result) || len(result) != n {
+            t.Errorf("Sort\n%v list is not sorted", name)
+        }
+        checkInvariants(t, "Sort", sorted)
+    }
+}
+
+func benchmarkSort(b *testing.B, list GoList[int]) {
+    b.ReportAllocs()
+    b.ResetTimer()
+    for i := 0; i < b.N; i++ {
+        Sort(list)
+    }
+}
+
+func BenchmarkSort_Sorted(b *testing.B) {
+    benchmarkSort(b, Seq(0, 99999, 1))
+}
+
+func BenchmarkSort_ReverseSorted(b *testing.B) {
+    benchmarkSort(b, Reverse(Seq(0, 99999, 1)))
+}
+
+func BenchmarkSort_Random(b *testing.B) {
+    benchmarkSort(b, FromSlice(rand.New(rand.NewSource(1)).Perm(100000)))
+}
+
+func TestMerge_UMerge_SortedLists(t *testing.T) {
+    list1 := New(1, 4, 4, 9)
+    list2 := New(2, 3, 4)
+    list3 := New[int]()
+    list4 := New(0, 9, 10)
+    merged := Merge(list1, list2, list3, list4)
+    umerged := UMerge(list1, list2, list3, list4)
+
+    expected1 := []int{0, 1, 2, 3, 4, 4, 4, 9, 9, 10}
+    expected2 := []int{0, 1, 2, 3, 4, 9, 10}
+
+    if result := ToSlice(merged); !reflect.DeepEqual(result, expected1) {
+        t.Errorf("Merge\nresult: %v\nexpected: %v", result, expected1)
+    }
+    if result := ToSlice(umerged); !reflect.DeepEqual(result, expected2) {
+        t.Errorf("UMerge\nresult: %v\nexpected: %v", result, expected2)
+    }
+    checkInvariants(t, "Merge", merged)
+    checkInvariants(t, "UMerge", umerged)
+}
+
+func TestMergeFunc_UMergeFunc_Stable(t *testing.T) {
+    list1 := New(person{"a", 10}, person{"b", 20}, person{"c", 20})
+    list2 := New(person{"d", 10}, person{"e", 20})
+    merged := MergeFunc(byAge, list1, list2)
+    umerged := UMergeFunc(byAge, list2, list1)
+
+    expected1 := []person{{"a", 10}, {"d", 10}, {"b", 20}, {"c", 20}, {"e", 20}}
+    expected2 := []person{{"d", 10}, {"e", 20}}
+
+    if result := ToSlice(merged); !reflect.DeepEqual(result, expected1) {
+        t.Errorf("MergeFunc\nresult: %v\nexpected: %v", result, expected1)
+    }
+    if result := ToSlice(umerged); !reflect.DeepEqual(result, expected2) {
+        t.Errorf("UMergeFunc\nresult: %v\nexpected: %v", result, expected2)
+    }
+}
+
+func BenchmarkMerge_SortedLists(b *testing.B) {
+    lists := make([]GoList[int], 8)
+    for i := range lists {
+        lists[i] = Seq(i, 99999, len(lists))
+    }
+    b.ReportAllocs()
+    b.ResetTimer()
+    for i := 0; i < b.N; i++ {
+        Merge(lists...)
+    }
+}
+
+func TestDeleteFunc(t *testing.T) {
+    list := New([]int{1}, []int{2, 3}, []int{4, 5})
+    deleted := DeleteFunc(list, func(s []int) bool { return len(s) == 2 })
+    expected := [][]int{{1}, {4, 5}}
+    if result := ToSlice(deleted); !reflect.DeepEqual(result, expected) {
+        t.Errorf("DeleteFunc\nresult: %v\nexpected: %v", result, expected)
+    }
+}
+
+func TestEqualFunc(t *testing.T) {
+    list1 := New([]int{1, 2}, []int{3})
+    list2 := New("1,2", "3")
+    eq := func(s []int, str string) bool {
+        return fmt.Sprint(s) == "["+strings.ReplaceAll(str, ",", " ")+"]"
+    }
+    if !EqualFunc(list1, list2, eq) {
+        t.Errorf("EqualFunc\nExpected true but got false")
+    }
+    if EqualFunc(list1, New("1,2"), eq) {
+        t.Errorf("EqualFunc\nExpected false but got true")
+    }
+}
+
+func TestIndexFunc(t *testing.T) {
+    list := New(1.5, 2.5, 3.5)
+    if result := IndexFunc(list, func(f float64) bool { return f > 2 }); result != 1 {
+        t.Errorf("IndexFunc\nresult: %v\nexpected: 1", result)
+    }
+    if result := IndexFunc(list, func(f float64) bool { return f > 4 }); result != -1 {
+        t.Errorf("IndexFunc\nresult: %v\nexpected: -1", result)
+    }
+}
+
+func TestSubtractFunc(t *testing.T) {
+    list1 := New("a", "B", "c", "b")
+    list2 := New("b", "C")
+    subtract := SubtractFunc(list1, list2, strings.EqualFold)
+    expected := []string{"a", "b"}
+    if result := ToSlice(subtract); !reflect.DeepEqual(result, expected) {
+        t.Errorf("SubtractFunc\nresult: %v\nexpected: %v", result, expected)
+    }
+}
+
+func TestEqual_UnexportedFields(t *testing.T) {
+    list1 := New(person{"a", 1}, person{"b", 2})
+    list2 := New(person{"a", 1}, person{"b", 2})
+    if !Equal(list1, list2) || !Member(list1, person{"b", 2}) {
+        t.Errorf("Equal, Member\nExpected true but got false")
+    }
+}
+
+func TestSubtract_Large(t *testing.T) {
+    list1 := Seq(0, 99999, 1)
+    list2 := Seq(1, 99999, 2)
+    subtract := Subtract(list1, list2)
+    if Len(subtract) != 50000 || !All(subtract, func(n int) bool { return n%2 == 0 }) {
+        t.Errorf("Subtract\nexpected 50000 even numbers")
+    }
+}
+
+func TestSetOperations(t *testing.T) {
+    list1 := New(3, 1, 3, 2, 5)
+    list2 := New(4, 2, 6, 3, 4)
+    results := map[string][]int{
+        "Union":               ToSlice(Union(list1, list2)),
+        "Intersect":           ToSlice(Intersect(list1, list2)),
+        "Difference":          ToSlice(Difference(list1, list2)),
+        "SymmetricDifference": ToSlice(SymmetricDifference(list1, list2)),
+    }
+    expected := map[string][]int{
+        "Union":               {3, 1, 2, 5, 4, 6},
+        "Intersect":           {3, 2},
+        "Difference":          {1, 5},
+        "SymmetricDifference": {1, 5, 4, 6},
+    }
+    if !reflect.DeepEqual(results, expected) {
+        t.Errorf("Set operations\nresult: %v\nexpected: %v", results, expected)
+    }
+}
+
+func TestUniq_UniqBy(t *testing.T) {
+    list := New("b", "a", "B", "c", "a", "A")
+    uniq := Uniq(list)
+    uniqBy := UniqBy(list, strings.ToLower)
+    expected1 := []string{"b", "a", "B", "c", "A"}
+    expected2 := []string{"b", "a", "c"}
+    if result := ToSlice(uniq); !reflect.DeepEqual(result, expected1) {
+        t.Errorf("Uniq\nresult: %v\nexpected: %v", result, expected1)
+    }
+    if result := ToSlice(uniqBy); !reflect.DeepEqual(result, expected2) {
+        t.Errorf("UniqBy\nresult: %v\nexpected: %v", result, expected2)
+    }
+}
+
+func TestMarshalJSON(t *testing.T) {
+    type wrapper struct {
+        Name  string
+        List  GoList[int]
+        Empty GoList[string]
+    }
+    data, err := json.Marshal(wrapper{Name: "x", List: New(1, 2, 3)})
+    expected := `{"Name":"x","List":[1,2,3],"Empty":[]}`
+    if err != nil || string(data) != expected {
+        t.Errorf("MarshalJSON\nresult: %s - %v\nexpected: %s", data, err, expected)
+    }
+}
+
+func TestUnmarshalJSON(t *testing.T) {
+    var list GoList[string]
+    if err := json.Unmarshal([]byte(`["a","b","c"]`), &list); err != nil {
+        t.Fatalf("UnmarshalJSON\nunexpected error: %v", err)
+    }
+    expected := []string{"a", "b", "c"}
+    if result := ToSlice(list); !reflect.DeepEqual(result, expected) {
+        t.Errorf("UnmarshalJSON\nresult: %v\nexpected: %v", result, expected)
+    }
+    if Last(list).Data != "c" {
+        t.Errorf("UnmarshalJSON\nTail: %v\nexpected: c", Last(list))
+    }
+
+    if err := json.Unmarshal([]byte(`null`), &list); err != nil || list.Head != nil {
+        t.Errorf("UnmarshalJSON\nresult: %v - %v\nexpected: []", list, err)
+    }
+    if err := json.Unmarshal([]byte(`{"a":1}`), &list); err == nil {
+        t.Errorf("UnmarshalJSON\nExpected error for JSON object")
+    }
+}
+
+func TestJSON_RoundTrip(t *testing.T) {
+    lists := []GoList[GoList[int]]{
+        New(New(1, 2), New[int](), New(3)),
+        New[GoList[int]](),
+    }
+    for _, list := range lists {
+        data, err := json.Marshal(list)
+        if err != nil {
+            t.Fatalf("MarshalJSON\nunexpected error: %v", err)
+        }
+        var decoded GoList[GoList[int]]
+        if err := json.Unmarshal(data, &decoded); err != nil {
+            t.Fatalf("UnmarshalJSON\nunexpected error: %v", err)
+        }
+        eq := func(a, b GoList[int]) bool { return Equal(a, b) }
+        if !EqualFunc(list, decoded, eq) {
+            t.Errorf("JSON round trip\nresult: %v\nexpected: %v", decoded, list)
+        }
+    }
+}
+
+func TestMarshalBinary_UnmarshalBinary(t *testing.T) {
+    lists := [][]int{{0, 1, 0, -2}, {}}
+    for _, values := range lists {
+        data, err := FromSlice(values).MarshalBinary()
+        if err != nil {
+            t.Fatalf("MarshalBinary\nunexpected error: %v", err)
+        }
+        var decoded GoList[int]
+        if err := decoded.UnmarshalBinary(data); err != nil {
+            t.Fatalf("UnmarshalBinary\nunexpected error: %v", err)
+        }
+        if result := ToSlice(decoded); !slices.Equal(result, values) {
+            t.Errorf("UnmarshalBinary\nresult: %v\nexpected: %v", result, values)
+        }
+    }
+
+    var decoded GoList[int]
+    if err := decoded.UnmarshalBinary([]byte("garbage")); err == nil {
+        t.Errorf("UnmarshalBinary\nExpected error for invalid data")
+    }
+
+    stale := New(1, 2, 3)
+    stale.Size = 2
+    if _, err := stale.MarshalBinary(); !errors.Is(err, ErrCorrupted) {
+        t.Errorf("MarshalBinary\nresult: %v\nexpected: %v", err, ErrCorrupted)
+    }
+}
+
+func TestGob_RoundTrip(t *testing.T) {
+    type wrapper struct {
+        Name  string
+        Lists GoList[GoList[string]]
+        Big   GoList[int]
+    }
+    input := wrapper{
+        Name:  "x",
+        Lists: New(New("a", "b"), New[string](), New("c")),
+        Big:   Seq(1, 100000, 1),
+    }
+
+    var buffer bytes.Buffer
+    if err := gob.NewEncoder(&buffer).Encode(input); err != nil {
+        t.Fatalf("GobEncode\nunexpected error: %v", err)
+    }
+    var output wrapper
+    if err := gob.NewDecoder(&buffer).Decode(&output); err != nil {
+        t.Fatalf("GobDecode\nunexpected error: %v", err)
+    }
+
+    eq := func(a, b GoList[string]) bool { return Equal(a, b) }
+    if output.Name != "x" || !EqualFunc(input.Lists, output.Lists, eq) {
+        t.Errorf("Gob round trip\nresult: %v\nexpected: %v", output.Lists, input.Lists)
+    }
+    if !Equal(input.Big, output.Big) {
+        t.Errorf("Gob round trip\nBig list is not decoded correctly")
+    }
+}
+
+func TestParse_RoundTrip(t *testing.T) {
+    numbers := New(-1, 0, 42, -7)
+    if result, err := ParseNumbers[int](numbers.String()); err != nil || !Equal(result, numbers) {
+        t.Errorf("ParseNumbers\nresult: %v, %v\nexpected: %v", result, err, numbers)
+    }
+    floats := New(1.5, -2.25, 1e21)
+    if result, err := ParseNumbers[float64](floats.String()); err != nil || !Equal(result, floats) {
+        t.Errorf("ParseNumbers\nresult: %v, %v\nexpected: %v", result, err, floats)
+    }
+    strs := New("a->b", "\"quoted\"", "]", "", "back\\slash")
+    if result, err := ParseStrings(strs.String()); err != nil || !Equal(result, strs) {
+        t.Errorf("ParseStrings\nresult: %v, %v\nexpected: %v", result, err, strs)
+    }
+    if result, err := ParseNumbers[int]("[]"); err != nil || result.Head != nil {
+        t.Errorf("ParseNumbers\nresult: %v, %v\nexpected: []", result, err)
+    }
+}
+
+func TestParse_Nested(t *testing.T) {
+    nested := New(New(1, 2), New[int](), New(3))
+    result, err := Parse(nested.String(), ParseNumbers[int])
+    if err != nil {
+        t.Fatalf("Parse\nunexpected error: %v", err)
+    }
+    eq := func(a, b GoList[int]) bool { return Equal(a, b) }
+    if !EqualFunc(result, nested, eq) {
+        t.Errorf("Parse\nresult: %v\nexpected: %v", result, nested)
+    }
+}
+
+func TestParse_Errors(t *testing.T) {
+    inputs := []string{"", "1->2]", "[1->2", "[1->->2]", "[1->2] x", "[1->abc]", "[1 2]"}
+    for _, input := range inputs {
+        if _, err := ParseNumbers[int](input); !errors.Is(err, ErrSyntax) {
+            t.Errorf("ParseNumbers(%q)\nresult: %v\nexpected: %v", input, err, ErrSyntax)
+        }
+    }
+    if _, err := ParseStrings(`["abc]`); !errors.Is(err, ErrSyntax) {
+        t.Errorf("ParseStrings\nresult: %v\nexpected: %v", err, ErrSyntax)
+    }
+    if result, err := ParseNumbers[int]("[1->2] \n"); err != nil || !Equal(result, New(1, 2)) {
+        t.Errorf("ParseNumbers\nresult: %v, %v\nexpected: [1 2]", result, err)
+    }
+}
+
+func TestWriteTo(t *testing.T) {
+    list := New("x", "y->z")
+    var builder strings.Builder
+    n, err := list.WriteTo(&builder)
+    if expected := list.String(); err != nil || builder.String() != expected || n != int64(len(expected)) {
+        t.Errorf("WriteTo\nresult: %q, %d, %v\nexpected: %q", builder.String(), n, err, expected)
+    }
+    if expected := `["x"->"y->z"]`; builder.String() != expected {
+        t.Errorf("WriteTo\nresult: %v\nexpected: %v", builder.String(), expected)
+    }
+}
+
+func TestFormat(t *testing.T) {
+    numbers, strs, empty := Seq(1, 5, 1), New("a", "b"), New[int]()
+    nested := New(New(1), New[int]())
+    tests := []struct {
+        format   string
+        list     any
+        expected string
+    }{
+        {"%v", numbers, `[1->2->3->4->5]`},
+        {"%+v", numbers, `[0:1->1:2->2:3->3:4->4:5]`},
+        {"%#v", numbers, `golist.New(1, 2, 3, 4, 5)`},
+        {"%.2v", numbers, `[1->2->...(5 total)]`},
+        {"%+.2v", numbers, `[0:1->1:2->...(5 total)]`},
+        {"%#.2v", numbers, `golist.New(1, 2, ... /* 5 total */)`},
+        {"%.0v", numbers, `[...(5 total)]`},
+        {"%.10v", numbers, `[1->2->3->4->5]`},
+        {"%02x", numbers, `[01->02->03->04->05]`},
+        {"%v", strs, `["a"->"b"]`},
+        {"%s", strs, `["a"->"b"]`},
+        {"%+v", New("é", "日本"), `[0:"é"->1:"日本"]`},
+        {"%#v", strs, `golist.New("a", "b")`},
+        {"%#v", empty, `golist.New[int]()`},
+        {"%.3v", empty, `[]`},
+        {"%#v", nested, `golist.New(golist.New(1), golist.New[int]())`},
+    }
+    for _, test := range tests {
+        if result := fmt.Sprintf(test.format, test.list); result != test.expected {
+            t.Errorf("Format %s\nresult: %v\nexpected: %v", test.format, result, test.expected)
+        }
+    }
+}
+
+func TestFormat_String(t *testing.T) {
+    list := Seq(1, 100, 1)
+    if result, expected := fmt.Sprint(list), list.String(); result != expected {
+        t.Errorf("Format\nresult: %v\nexpected: %v", result, expected)
+    }
+}
+
+func TestValidate(t *testing.T) {
+    if err := Validate(New(1, 2, 3)); err != nil {
+        t.Errorf("Validate\nunexpected error: %v", err)
+    }
+    if err := Validate(New[int]()); err != nil {
+        t.Errorf("Validate\nunexpected error: %v", err)
+    }
+
+    looped := New(1, 2, 3, 4)
+    looped.Tail.Next = looped.Head.Next
+    badTail := New(1, 2, 3)
+    badTail.Tail = badTail.Head
+    badSize := New(1, 2, 3)
+    badSize.Size = 2
+    tests := map[string]GoList[int]{
+        "cycle starts at node 1": looped,
+        "Tail is not the last":   badTail,
+        "Size is 2":              badSize,
+    }
+    for message, list := range tests {
+        err := Validate(list)
+        if !errors.Is(err, ErrCorrupted) || !strings.Contains(err.Error(), message) {
+            t.Errorf("Validate\nresult: %v\nexpected: %v", err, message)
+        }
+    }
+}
+
+func TestCycleStart(t *testing.T) {
+    list := New(1, 2, 3, 4, 5)
+    if HasCycle(list) || CycleStart(list) != nil {
+        t.Errorf("HasCycle\nresult: true\nexpected: false")
+    }
+    start := list.Head.Next.Next
+    list.Tail.Next = start
+    if !HasCycle(list) || CycleStart(list) != start {
+        t.Errorf("CycleStart\nresult: %p\nexpected: %p", CycleStart(list), start)
+    }
+    single := New(1)
+    single.Head.Next = single.Head
+    if CycleStart(single) != single.Head {
+        t.Errorf("CycleStart\nresult: %p\nexpected: %p", CycleStart(single), single.Head)
+    }
+}
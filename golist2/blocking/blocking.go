@@ -0,0 +1,232 @@
+// Package blocking contains a FIFO queue on doubly linked list, which blocks
+// producers while it is full and consumers while it is empty, like a channel.
+package blocking
+
+import (
+    "context"
+    "errors"
+    "sync"
+    "github.com/hiennguyen-neih/go-linkedlist/golist2"
+)
+
+/*
+ *******************************************************************************
+ * Define structs and interfaces
+ *******************************************************************************
+ */
+
+// Bounded or unbounded FIFO queue safe for concurrent use. Unlike a channel,
+// the items waiting in the queue can be inspected and removed. The zero value
+// is an empty unbounded queue ready to use. A Queue must not be copied after
+// first use.
+type Queue[T any] struct {
+    mutex    sync.Mutex
+    list     golist2.GoList2[T]
+    capacity int              // Maximum number of items, 0 if unbounded.
+    closed   bool
+    changed  chan struct{}    // Closed and replaced whenever the queue changes.
+}
+
+// Error returned by Put and Take when the queue is closed.
+var ErrClosed = errors.New("blocking: queue is closed")
+
+/*
+ *******************************************************************************
+ * Exported functions
+ *******************************************************************************
+ */
+
+// Returns a new queue holding at most capacity items. The queue is unbounded
+// if capacity is 0 or negative.
+func New[T any](capacity int) *Queue[T] {
+    return &Queue[T]{capacity: max(capacity, 0)}
+}
+
+/*
+ *******************************************************************************
+ * Exported methods
+ *******************************************************************************
+ */
+
+// Returns maximum number of items of the queue, or 0 if it is unbounded.
+func (queue *Queue[T]) Cap() int {
+    return queue.capacity
+}
+
+// Returns number of items waiting in the queue.
+func (queue *Queue[T]) Len() int {
+    queue.mutex.Lock()
+    defer queue.mutex.Unlock()
+    return queue.list.Size
+}
+
+// Returns the front item of the queue and true without removing it, or zero
+// value and false if the queue is empty.
+func (queue *Queue[T]) Peek() (T, bool) {
+    queue.mutex.Lock()
+    defer queue.mutex.Unlock()
+    if queue.list.Head == nil {
+        var zero T
+        return zero, false
+    }
+    return queue.list.Head.Data, true
+}
+
+// Returns a copy of the items waiting in the queue, from front to back.
+func (queue *Queue[T]) Snapshot() golist2.GoList2[T] {
+    queue.mutex.Lock()
+    defer queue.mutex.Unlock()
+    return golist2.Concat(queue.list)
+}
+
+/*
+ *******************************************************************************
+ * Exported in-place methods
+ *******************************************************************************
+ */
+
+// Closes the queue. Blocked and later Put calls return ErrClosed. Take calls
+// still receive the remaining items, then return ErrClosed.
+func (queue *Queue[T]) Close() {
+    queue.mutex.Lock()
+    defer queue.mutex.Unlock()
+    if !queue.closed {
+        queue.closed = true
+        queue.notify()
+    }
+}
+
+// Removes all items waiting in the queue and returns them, from front to back.
+func (queue *Queue[T]) Drain() golist2.GoList2[T] {
+    queue.mutex.Lock()
+    defer queue.mutex.Unlock()
+    result := queue.list
+    queue.list = golist2.GoList2[T]{}
+    queue.notify()
+    return result
+}
+
+// Inserts value at the back of the queue, waiting while the queue is full.
+// Returns ErrClosed if the queue is closed, or the error of ctx if it is done
+// before there is room for value.
+func (queue *Queue[T]) Put(ctx context.Context, value T) error {
+    for {
+        queue.mutex.Lock()
+        if queue.closed {
+            queue.mutex.Unlock()
+            return ErrClosed
+        }
+        if queue.hasRoom() {
+            queue.list.PushBack(value)
+            queue.notify()
+            queue.mutex.Unlock()
+            return nil
+        }
+        if err := queue.wait(ctx); err != nil {
+            return err
+        }
+    }
+}
+
+// Removes every item that fun returns true for. Returns number of removed
+// items.
+func (queue *Queue[T]) RemoveFunc(fun func(T) bool) int {
+    queue.mutex.Lock()
+    defer queue.mutex.Unlock()
+    count := 0
+    for node := queue.list.Head; node != nil; {
+        next := node.Next
+        if fun(node.Data) {
+            queue.list.Remove(node)
+            count++
+        }
+        node = next
+    }
+    if count > 0 {
+        queue.notify()
+    }
+    return count
+}
+
+// Removes the front item of the queue and returns it, waiting while the queue
+// is empty. Returns ErrClosed if the queue is closed and empty, or the error
+// of ctx if it is done before an item arrives.
+func (queue *Queue[T]) Take(ctx context.Context) (T, error) {
+    for {
+        queue.mutex.Lock()
+        if value, ok := queue.list.PopFront(); ok {
+            queue.notify()
+            queue.mutex.Unlock()
+            return value, nil
+        }
+        if queue.closed {
+            queue.mutex.Unlock()
+            var zero T
+            return zero, ErrClosed
+        }
+        if err := queue.wait(ctx); err != nil {
+            var zero T
+            return zero, err
+        }
+    }
+}
+
+// Same as Put, but never waits. Returns false if the queue is full or closed.
+func (queue *Queue[T]) TryPut(value T) bool {
+    queue.mutex.Lock()
+    defer queue.mutex.Unlock()
+    if queue.closed || !queue.hasRoom() {
+        return false
+    }
+    queue.list.PushBack(value)
+    queue.notify()
+    return true
+}
+
+// Same as Take, but never waits. Returns zero value and false if the queue is
+// empty.
+func (queue *Queue[T]) TryTake() (T, bool) {
+    queue.mutex.Lock()
+    defer queue.mutex.Unlock()
+    value, ok := queue.list.PopFront()
+    if ok {
+        queue.notify()
+    }
+    return value, ok
+}
+
+/*
+ *******************************************************************************
+ * Internal functions and methods
+ *******************************************************************************
+ */
+
+// Returns true if one more item fits in the queue. Must hold the mutex.
+func (queue *Queue[T]) hasRoom() bool {
+    return queue.capacity == 0 || queue.list.Size < queue.capacity
+}
+
+// Do wake up every goroutine waiting for the queue to change. Must hold the
+// mutex.
+func (queue *Queue[T]) notify() {
+    if queue.changed != nil {
+        close(queue.changed)
+        queue.changed = nil
+    }
+}
+
+// Do release the mutex, then wait until the queue changes or ctx is done.
+// Must hold the mutex.
+func (queue *Queue[T]) wait(ctx context.Context) error {
+    if queue.changed == nil {
+        queue.changed = make(chan struct{})
+    }
+    changed := queue.changed
+    queue.mutex.Unlock()
+    select {
+    case <-changed:
+        return nil
+    case <-ctx.Done():
+        return ctx.Err()
+    }
+}
@@ -0,0 +1,182 @@
+package blocking
+
+import (
+    "context"
+    "errors"
+    "sync"
+    "testing"
+    "time"
+    "github.com/hiennguyen-neih/go-linkedlist/golist2"
+)
+
+func TestPutTake(t *testing.T) {
+    queue := New[int](2)
+    ctx := context.Background()
+    for i := 1; i <= 2; i++ {
+        if err := queue.Put(ctx, i); err != nil {
+            t.Fatalf("Put\nunexpected error: %v", err)
+        }
+    }
+    if queue.TryPut(3) {
+        t.Errorf("TryPut\nresult: true\nexpected: false")
+    }
+    if result, expected := queue.Snapshot(), golist2.New(1, 2); !golist2.Equal(result, expected) {
+        t.Errorf("Snapshot\nresult: %v\nexpected: %v", result, expected)
+    }
+    for i := 1; i <= 2; i++ {
+        if result, err := queue.Take(ctx); err != nil || result != i {
+            t.Errorf("Take\nresult: %v, %v\nexpected: %v", result, err, i)
+        }
+    }
+    if _, ok := queue.TryTake(); ok {
+        t.Errorf("TryTake\nresult: true\nexpected: false")
+    }
+}
+
+func TestUnbounded(t *testing.T) {
+    var queue Queue[int]
+    for i := 0; i < 1000; i++ {
+        if !queue.TryPut(i) {
+            t.Fatalf("TryPut\nresult: false\nexpected: true")
+        }
+    }
+    if result := queue.Len(); result != 1000 || queue.Cap() != 0 {
+        t.Errorf("Len\nresult: %v, %v\nexpected: 1000, 0", result, queue.Cap())
+    }
+}
+
+func TestPut_Blocking(t *testing.T) {
+    queue := New[int](1)
+    queue.TryPut(1)
+    done := make(chan error)
+    go func() {
+        done <- queue.Put(context.Background(), 2)
+    }()
+
+    select {
+    case <-done:
+        t.Fatalf("Put\ndid not block on a full queue")
+    case <-time.After(20 * time.Millisecond):
+    }
+    if result, _ := queue.TryTake(); result != 1 {
+        t.Errorf("TryTake\nresult: %v\nexpected: %v", result, 1)
+    }
+    if err := <-done; err != nil {
+        t.Errorf("Put\nunexpected error: %v", err)
+    }
+    if result, _ := queue.Peek(); result != 2 {
+        t.Errorf("Peek\nresult: %v\nexpected: %v", result, 2)
+    }
+}
+
+func TestContext(t *testing.T) {
+    queue := New[int](1)
+    ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+    defer cancel()
+    if _, err := queue.Take(ctx); !errors.Is(err, context.DeadlineExceeded) {
+        t.Errorf("Take\nresult: %v\nexpected: %v", err, context.DeadlineExceeded)
+    }
+
+    queue.TryPut(1)
+    ctx, cancel = context.WithCancel(context.Background())
+    go cancel()
+    if err := queue.Put(ctx, 2); !errors.Is(err, context.Canceled) {
+        t.Errorf("Put\nresult: %v\nexpected: %v", err, context.Canceled)
+    }
+    if result := queue.Len(); result != 1 {
+        t.Errorf("Len\nresult: %v\nexpected: %v", result, 1)
+    }
+}
+
+func TestClose(t *testing.T) {
+    queue := New[int](0)
+    queue.TryPut(1)
+    taken := make(chan error, 2)
+    go func() {
+        _, err := queue.Take(context.Background())
+        taken <- err
+        _, err = queue.Take(context.Background())
+        taken <- err
+    }()
+    if err := <-taken; err != nil {
+        t.Errorf("Take\nunexpected error: %v", err)
+    }
+    queue.Close()
+    if err := <-taken; !errors.Is(err, ErrClosed) {
+        t.Errorf("Take\nresult: %v\nexpected: %v", err, ErrClosed)
+    }
+    if err := queue.Put(context.Background(), 2); !errors.Is(err, ErrClosed) {
+        t.Errorf("Put\nresult: %v\nexpected: %v", err, ErrClosed)
+    }
+    if queue.TryPut(2) {
+        t.Errorf("TryPut\nresult: true\nexpected: false")
+    }
+}
+
+func TestDrain(t *testing.T) {
+    queue := New[int](3)
+    for i := 1; i <= 3; i++ {
+        queue.TryPut(i)
+    }
+    queue.Close()
+    if result, expected := queue.Drain(), golist2.New(1, 2, 3); !golist2.Equal(result, expected) {
+        t.Errorf("Drain\nresult: %v\nexpected: %v", result, expected)
+    }
+    if _, err := queue.Take(context.Background()); !errors.Is(err, ErrClosed) {
+        t.Errorf("Take\nresult: %v\nexpected: %v", err, ErrClosed)
+    }
+}
+
+func TestRemoveFunc(t *testing.T) {
+    queue := New[int](4)
+    for i := 1; i <= 4; i++ {
+        queue.TryPut(i)
+    }
+    isEven := func(x int) bool { return x%2 == 0 }
+    if result := queue.RemoveFunc(isEven); result != 2 {
+        t.Errorf("RemoveFunc\nresult: %v\nexpected: %v", result, 2)
+    }
+    if result, expected := queue.Snapshot(), golist2.New(1, 3); !golist2.Equal(result, expected) {
+        t.Errorf("RemoveFunc\nresult: %v\nexpected: %v", result, expected)
+    }
+}
+
+func TestConcurrent(t *testing.T) {
+    const producers, perProducer = 4, 1000
+    queue := New[int](8)
+    ctx := context.Background()
+    var wg sync.WaitGroup
+    for p := 0; p < producers; p++ {
+        wg.Add(1)
+        go func(p int) {
+            defer wg.Done()
+            for i := 0; i < perProducer; i++ {
+                if err := queue.Put(ctx, p*perProducer+i); err != nil {
+                    t.Errorf("Put\nunexpected error: %v", err)
+                    return
+                }
+            }
+        }(p)
+    }
+    go func() {
+        wg.Wait()
+        queue.Close()
+    }()
+
+    seen := make([]bool, producers*perProducer)
+    for {
+        value, err := queue.Take(ctx)
+        if errors.Is(err, ErrClosed) {
+            break
+        }
+        if seen[value] {
+            t.Fatalf("Take\nvalue %v is duplicated", value)
+        }
+        seen[value] = true
+    }
+    for value, ok := range seen {
+        if !ok {
+            t.Fatalf("Take\nvalue %v is lost", value)
+        }
+    }
+}
@@ -0,0 +1,474 @@
+// Package concurrent contains a doubly linked list that is safe for concurrent
+// use by multiple goroutines.
+package concurrent
+
+import (
+    "fmt"
+    "iter"
+    "sync"
+    "github.com/hiennguyen-neih/go-linkedlist/golist2"
+    "github.com/hiennguyen-neih/go-linkedlist/node"
+    "github.com/hiennguyen-neih/go-linkedlist/constraints"
+)
+
+/*
+ *******************************************************************************
+ * Define structs and interfaces
+ *******************************************************************************
+ */
+
+// Doubly linked list guarded by a read-write mutex. The zero value is an empty
+// list ready to use. A List must not be copied after first use.
+//
+// Nodes of the list are never exposed, so every access goes through the lock.
+// The functions of this package mirror those of golist2 and hold the read
+// lock while they run, returning new lists or node data instead of nodes.
+// Callbacks passed to them run under that lock, so they must not modify the
+// list. Any other change can be made with Update.
+type List[T any] struct {
+    mutex sync.RWMutex
+    list  golist2.GoList2[T]
+}
+
+/*
+ *******************************************************************************
+ * Exported functions
+ *******************************************************************************
+ */
+
+// Returns a new concurrent list containing all input elements.
+func New[T any](elems ...T) *List[T] {
+    return &List[T]{list: golist2.New(elems...)}
+}
+
+// Returns a new concurrent list containing a copy of the nodes of list.
+func FromList[T any](list golist2.GoList2[T]) *List[T] {
+    return &List[T]{list: golist2.Concat(list)}
+}
+
+// Returns true if fun returns true for every node data of list.
+func All[T any](list *List[T], fun func(T) bool) bool {
+    list.mutex.RLock()
+    defer list.mutex.RUnlock()
+    return golist2.All(list.list, fun)
+}
+
+// Returns true if fun returns true for at least one node data of list.
+func Any[T any](list *List[T], fun func(T) bool) bool {
+    list.mutex.RLock()
+    defer list.mutex.RUnlock()
+    return golist2.Any(list.list, fun)
+}
+
+// Returns a new list containing nodes data of list that fun returns true for.
+func Filter[T any](list *List[T], fun func(T) bool) *List[T] {
+    list.mutex.RLock()
+    defer list.mutex.RUnlock()
+    return &List[T]{list: golist2.Filter(list.list, fun)}
+}
+
+// Returns a new list containing the second values returned by fun for nodes
+// data of list that fun returns true for.
+func FilterMap[T1, T2 any](list *List[T1], fun func(T1) (bool, T2)) *List[T2] {
+    list.mutex.RLock()
+    defer list.mutex.RUnlock()
+    return &List[T2]{list: golist2.FilterMap(list.list, fun)}
+}
+
+// Returns position of the first node of list which data is value, or -1 if
+// there is no such node.
+func Find[T comparable](list *List[T], value T) int {
+    list.mutex.RLock()
+    defer list.mutex.RUnlock()
+    return golist2.Find(list.list, value)
+}
+
+// Calls fun on successive nodes data of list, from head to tail, starting with
+// acc0. Returns the final value of the accumulator.
+func Foldl[T1, T2 any](list *List[T1], acc0 T2, fun func(T1, T2) T2) T2 {
+    list.mutex.RLock()
+    defer list.mutex.RUnlock()
+    return golist2.Foldl(list.list, acc0, fun)
+}
+
+// Same as Foldl, but from tail to head.
+func Foldr[T1, T2 any](list *List[T1], acc0 T2, fun func(T1, T2) T2) T2 {
+    list.mutex.RLock()
+    defer list.mutex.RUnlock()
+    return golist2.Foldr(list.list, acc0, fun)
+}
+
+// Calls fun on every node data of list, from head to tail.
+func ForEach[T any](list *List[T], fun func(T)) {
+    list.mutex.RLock()
+    defer list.mutex.RUnlock()
+    golist2.ForEach(list.list, fun)
+}
+
+// Returns position of the first node of list that fun returns true for, or -1
+// if there is no such node.
+func IndexFunc[T any](list *List[T], fun func(T) bool) int {
+    list.mutex.RLock()
+    defer list.mutex.RUnlock()
+    return golist2.IndexFunc(list.list, fun)
+}
+
+// Returns a new list containing the results of fun for every node data of
+// list.
+func Map[T1, T2 any](list *List[T1], fun func(T1) T2) *List[T2] {
+    list.mutex.RLock()
+    defer list.mutex.RUnlock()
+    return &List[T2]{list: golist2.Map(list.list, fun)}
+}
+
+// Returns the greatest node data of list and true, or zero value and false if
+// list is empty. This function only works with constraint Ordered list.
+func Max[T constraints.Ordered](list *List[T]) (T, bool) {
+    list.mutex.RLock()
+    defer list.mutex.RUnlock()
+    return nodeData(golist2.Max(list.list))
+}
+
+// Same as Max, but using cmp to compare node data.
+func MaxFunc[T any](list *List[T], cmp func(a, b T) int) (T, bool) {
+    list.mutex.RLock()
+    defer list.mutex.RUnlock()
+    return nodeData(golist2.MaxFunc(list.list, cmp))
+}
+
+// Returns true if elem is a node data of list.
+func Member[T comparable](list *List[T], elem T) bool {
+    list.mutex.RLock()
+    defer list.mutex.RUnlock()
+    return golist2.Member(list.list, elem)
+}
+
+// Returns the least node data of list and true, or zero value and false if
+// list is empty. This function only works with constraint Ordered list.
+func Min[T constraints.Ordered](list *List[T]) (T, bool) {
+    list.mutex.RLock()
+    defer list.mutex.RUnlock()
+    return nodeData(golist2.Min(list.list))
+}
+
+// Same as Min, but using cmp to compare node data.
+func MinFunc[T any](list *List[T], cmp func(a, b T) int) (T, bool) {
+    list.mutex.RLock()
+    defer list.mutex.RUnlock()
+    return nodeData(golist2.MinFunc(list.list, cmp))
+}
+
+// Returns data of the node at specific index of list. Negative index indicate
+// an offset from the end of list. Panics if index is out of bound, see TryNth
+// for a variant returning an error.
+func Nth[T any](list *List[T], index int) T {
+    list.mutex.RLock()
+    defer list.mutex.RUnlock()
+    return golist2.Nth(list.list, index).Data
+}
+
+// Returns a new list containing nodes data of list in reverse order.
+func Reverse[T any](list *List[T]) *List[T] {
+    list.mutex.RLock()
+    defer list.mutex.RUnlock()
+    return &List[T]{list: golist2.Reverse(list.list)}
+}
+
+// Returns position and data of the first node of list that fun returns true
+// for, or -1 and zero value if there is no such node.
+func Search[T any](list *List[T], fun func(T) bool) (int, T) {
+    list.mutex.RLock()
+    defer list.mutex.RUnlock()
+    index, node := golist2.Search(list.list, fun)
+    data, _ := nodeData(node)
+    return index, data
+}
+
+// Returns a new list containing the sorted nodes data of list. This function
+// only works with constraint Ordered list.
+func Sort[T constraints.Ordered](list *List[T]) *List[T] {
+    list.mutex.RLock()
+    defer list.mutex.RUnlock()
+    return &List[T]{list: golist2.Sort(list.list)}
+}
+
+// Returns a new list containing nodes data of list sorted in ascending order
+// as determined by cmp.
+func SortFunc[T any](list *List[T], cmp func(a, b T) int) *List[T] {
+    list.mutex.RLock()
+    defer list.mutex.RUnlock()
+    return &List[T]{list: golist2.SortFunc(list.list, cmp)}
+}
+
+// Same as SortFunc, but keeping the original order of nodes that compare
+// equal.
+func SortStableFunc[T any](list *List[T], cmp func(a, b T) int) *List[T] {
+    list.mutex.RLock()
+    defer list.mutex.RUnlock()
+    return &List[T]{list: golist2.SortStableFunc(list.list, cmp)}
+}
+
+// Returns a new list containing at most len nodes data of list, starting at
+// start. Negative start indicate an offset from the end of list. Panics if
+// start is out of bound or len is negative.
+func Sublist[T any](list *List[T], start, len int) *List[T] {
+    list.mutex.RLock()
+    defer list.mutex.RUnlock()
+    return &List[T]{list: golist2.Sublist(list.list, start, len)}
+}
+
+// Returns sum of nodes data of list. This function only works with constraint
+// Ordered list.
+func Sum[T constraints.Ordered](list *List[T]) T {
+    list.mutex.RLock()
+    defer list.mutex.RUnlock()
+    return golist2.Sum(list.list)
+}
+
+// Same as Nth, but returns golist2.ErrEmptyList if list is empty and
+// golist2.ErrIndexOutOfRange if index is out of bound.
+func TryNth[T any](list *List[T], index int) (T, error) {
+    list.mutex.RLock()
+    defer list.mutex.RUnlock()
+    node, err := golist2.TryNth(list.list, index)
+    data, _ := nodeData(node)
+    return data, err
+}
+
+// Returns a new list containing the distinct nodes data of list, in order of
+// their first occurrence.
+func Uniq[T comparable](list *List[T]) *List[T] {
+    list.mutex.RLock()
+    defer list.mutex.RUnlock()
+    return &List[T]{list: golist2.Uniq(list.list)}
+}
+
+// Returns a new list containing the sorted distinct nodes data of list. This
+// function only works with constraint Ordered list.
+func USort[T constraints.Ordered](list *List[T]) *List[T] {
+    list.mutex.RLock()
+    defer list.mutex.RUnlock()
+    return &List[T]{list: golist2.USort(list.list)}
+}
+
+/*
+ *******************************************************************************
+ * Exported methods
+ *******************************************************************************
+ */
+
+// Returns an iterator over a snapshot of node data, from head to tail. The
+// snapshot is taken when iteration starts, so the list may be modified, even
+// from the loop body, while iterating.
+func (list *List[T]) All() iter.Seq[T] {
+    return func(yield func(T) bool) {
+        list.Snapshot().All()(yield)
+    }
+}
+
+// Returns an iterator over a snapshot of node data, from tail to head.
+func (list *List[T]) Backward() iter.Seq[T] {
+    return func(yield func(T) bool) {
+        list.Snapshot().Backward()(yield)
+    }
+}
+
+// Returns an iterator over index and data of a snapshot of the list, from
+// head to tail.
+func (list *List[T]) Enumerate() iter.Seq2[int, T] {
+    return func(yield func(int, T) bool) {
+        list.Snapshot().Enumerate()(yield)
+    }
+}
+
+// Returns data of the first node and true, or zero value and false if list is
+// empty.
+func (list *List[T]) Front() (T, bool) {
+    list.mutex.RLock()
+    defer list.mutex.RUnlock()
+    if list.list.Head == nil {
+        var zero T
+        return zero, false
+    }
+    return list.list.Head.Data, true
+}
+
+// Returns data of the last node and true, or zero value and false if list is
+// empty.
+func (list *List[T]) Back() (T, bool) {
+    list.mutex.RLock()
+    defer list.mutex.RUnlock()
+    if list.list.Tail == nil {
+        var zero T
+        return zero, false
+    }
+    return list.list.Tail.Data, true
+}
+
+// Returns number of nodes of the list.
+func (list *List[T]) Len() int {
+    list.mutex.RLock()
+    defer list.mutex.RUnlock()
+    return list.list.Size
+}
+
+// Returns a copy of the list that can be used without locking.
+func (list *List[T]) Snapshot() golist2.GoList2[T] {
+    list.mutex.RLock()
+    defer list.mutex.RUnlock()
+    return golist2.Concat(list.list)
+}
+
+// Returns a string representing the list, same as golist2.GoList2.
+func (list *List[T]) String() string {
+    list.mutex.RLock()
+    defer list.mutex.RUnlock()
+    return list.list.String()
+}
+
+// Implements fmt.Formatter, formatting the list same as golist2.GoList2.
+func (list *List[T]) Format(state fmt.State, verb rune) {
+    list.mutex.RLock()
+    defer list.mutex.RUnlock()
+    list.list.Format(state, verb)
+}
+
+// Calls fun with a pointer to the list while holding the write lock, so the
+// list can be modified or replaced with the result of any golist2 function.
+// The list must not be retained after fun returns.
+func (list *List[T]) Update(fun func(*golist2.GoList2[T])) {
+    list.mutex.Lock()
+    defer list.mutex.Unlock()
+    fun(&list.list)
+}
+
+/*
+ *******************************************************************************
+ * Exported in-place methods
+ *******************************************************************************
+ */
+
+// Inserts value at the front of list.
+func (list *List[T]) PushFront(value T) {
+    list.mutex.Lock()
+    defer list.mutex.Unlock()
+    list.list.PushFront(value)
+}
+
+// Inserts value at the back of list.
+func (list *List[T]) PushBack(value T) {
+    list.mutex.Lock()
+    defer list.mutex.Unlock()
+    list.list.PushBack(value)
+}
+
+// Removes the first node of list. Returns its data and true, or zero value and
+// false if list is empty.
+func (list *List[T]) PopFront() (T, bool) {
+    list.mutex.Lock()
+    defer list.mutex.Unlock()
+    return list.list.PopFront()
+}
+
+// Removes the last node of list. Returns its data and true, or zero value and
+// false if list is empty.
+func (list *List[T]) PopBack() (T, bool) {
+    list.mutex.Lock()
+    defer list.mutex.Unlock()
+    return list.list.PopBack()
+}
+
+// Removes all nodes of list.
+func (list *List[T]) Clear() {
+    list.mutex.Lock()
+    defer list.mutex.Unlock()
+    list.list = golist2.GoList2[T]{}
+}
+
+// Removes the node at specific index of list and returns its data. Negative
+// index indicate an offset from the end of list. Returns golist2.ErrEmptyList
+// if list is empty and golist2.ErrIndexOutOfRange if index is out of bound.
+func (list *List[T]) DeleteAt(index int) (T, error) {
+    list.mutex.Lock()
+    defer list.mutex.Unlock()
+    node, err := golist2.TryNth(list.list, index)
+    if err != nil {
+        var zero T
+        return zero, err
+    }
+    return list.list.Remove(node), nil
+}
+
+// Inserts value at specific index of list, so index == Len() inserts it at the
+// back. Negative index indicate an offset from the end of list. Returns
+// golist2.ErrIndexOutOfRange if index is out of bound.
+func (list *List[T]) InsertAt(index int, value T) error {
+    list.mutex.Lock()
+    defer list.mutex.Unlock()
+    if index < 0 {
+        index = list.list.Size + index // same as len - abs(index)
+    }
+    if index < 0 || index > list.list.Size {
+        return golist2.ErrIndexOutOfRange
+    }
+    if index == 0 {
+        list.list.PushFront(value)
+    } else {
+        list.list.InsertAfter(golist2.Nth(list.list, index-1), value)
+    }
+    return nil
+}
+
+// Removes every node of list that fun returns true for. Returns number of
+// removed nodes.
+func (list *List[T]) RemoveFunc(fun func(T) bool) int {
+    list.mutex.Lock()
+    defer list.mutex.Unlock()
+    count := 0
+    for node := list.list.Head; node != nil; {
+        next := node.Next
+        if fun(node.Data) {
+            list.list.Remove(node)
+            count++
+        }
+        node = next
+    }
+    return count
+}
+
+// Replaces data of the node at specific index of list with value. Negative
+// index indicate an offset from the end of list. Returns golist2.ErrEmptyList
+// if list is empty and golist2.ErrIndexOutOfRange if index is out of bound.
+func (list *List[T]) ReplaceAt(index int, value T) error {
+    return list.UpdateAt(index, func(T) T { return value })
+}
+
+// Updates data of the node at specific index of list with returns value of
+// fun, called under the write lock. Negative index indicate an offset from
+// the end of list. Returns golist2.ErrEmptyList if list is empty and
+// golist2.ErrIndexOutOfRange if index is out of bound.
+func (list *List[T]) UpdateAt(index int, fun func(T) T) error {
+    list.mutex.Lock()
+    defer list.mutex.Unlock()
+    node, err := golist2.TryNth(list.list, index)
+    if err != nil {
+        return err
+    }
+    node.Data = fun(node.Data)
+    return nil
+}
+
+/*
+ *******************************************************************************
+ * Internal functions and methods
+ *******************************************************************************
+ */
+
+// Returns data of node and true, or zero value and false if node is nil.
+func nodeData[T any](node *node.Node2[T]) (T, bool) {
+    if node == nil {
+        var zero T
+        return zero, false
+    }
+    return node.Data, true
+}
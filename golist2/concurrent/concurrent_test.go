@@ -0,0 +1,285 @@
+package concurrent
+
+import (
+    "errors"
+    "fmt"
+    "slices"
+    "sync"
+    "testing"
+    "github.com/hiennguyen-neih/go-linkedlist/golist2"
+)
+
+const (
+    workers   = 8
+    perWorker = 1000
+)
+
+func TestNew(t *testing.T) {
+    list := New(1, 2, 3)
+    if result, expected := list.String(), "[1<->2<->3]"; result != expected {
+        t.Errorf("New\nresult: %v\nexpected: %v", result, expected)
+    }
+    if result, expected := fmt.Sprintf("%.1v", list), "[1<->...(3 total)]"; result != expected {
+        t.Errorf("Format\nresult: %v\nexpected: %v", result, expected)
+    }
+}
+
+func TestFromList(t *testing.T) {
+    source := golist2.New(1, 2, 3)
+    list := FromList(source)
+    source.Head.Data = 9
+    if result, expected := list.Snapshot(), golist2.New(1, 2, 3); !golist2.Equal(result, expected) {
+        t.Errorf("FromList\nresult: %v\nexpected: %v", result, expected)
+    }
+}
+
+func TestZeroValue(t *testing.T) {
+    var list List[int]
+    if _, ok := list.PopFront(); ok {
+        t.Errorf("PopFront\nresult: true\nexpected: false")
+    }
+    if _, ok := list.Front(); ok {
+        t.Errorf("Front\nresult: true\nexpected: false")
+    }
+    list.PushBack(1)
+    list.PushFront(0)
+    if front, _ := list.Front(); front != 0 {
+        t.Errorf("Front\nresult: %v\nexpected: %v", front, 0)
+    }
+    if back, _ := list.Back(); back != 1 {
+        t.Errorf("Back\nresult: %v\nexpected: %v", back, 1)
+    }
+    list.Clear()
+    if result := list.Len(); result != 0 {
+        t.Errorf("Clear\nresult: %v\nexpected: %v", result, 0)
+    }
+}
+
+func TestUpdate(t *testing.T) {
+    list := New(1, 2, 3, 4, 5, 6)
+    list.Update(func(l *golist2.GoList2[int]) {
+        *l = golist2.Filter(*l, func(x int) bool { return x%2 == 0 })
+    })
+    if result, expected := Sum(list), 12; result != expected {
+        t.Errorf("Update\nresult: %v\nexpected: %v", result, expected)
+    }
+}
+
+func TestFunctions(t *testing.T) {
+    list := New(3, 1, 4, 1, 5)
+    even := func(x int) bool { return x%2 == 0 }
+    if result, expected := Filter(list, even).String(), "[4]"; result != expected {
+        t.Errorf("Filter\nresult: %v\nexpected: %v", result, expected)
+    }
+    double := func(x int) string { return fmt.Sprint(x * 2) }
+    if result, expected := Map(list, double).String(), `["6"<->"2"<->"8"<->"2"<->"10"]`; result != expected {
+        t.Errorf("Map\nresult: %v\nexpected: %v", result, expected)
+    }
+    if result, expected := Sort(list).String(), "[1<->1<->3<->4<->5]"; result != expected {
+        t.Errorf("Sort\nresult: %v\nexpected: %v", result, expected)
+    }
+    if result, expected := USort(list).String(), "[1<->3<->4<->5]"; result != expected {
+        t.Errorf("USort\nresult: %v\nexpected: %v", result, expected)
+    }
+    if result, expected := Find(list, 4), 2; result != expected {
+        t.Errorf("Find\nresult: %v\nexpected: %v", result, expected)
+    }
+    if index, value := Search(list, even); index != 2 || value != 4 {
+        t.Errorf("Search\nresult: %v, %v\nexpected: %v, %v", index, value, 2, 4)
+    }
+    if result, expected := Nth(list, -1), 5; result != expected {
+        t.Errorf("Nth\nresult: %v\nexpected: %v", result, expected)
+    }
+    if _, err := TryNth(list, 5); !errors.Is(err, golist2.ErrIndexOutOfRange) {
+        t.Errorf("TryNth\nresult: %v\nexpected: %v", err, golist2.ErrIndexOutOfRange)
+    }
+    if result, ok := Max(list); !ok || result != 5 {
+        t.Errorf("Max\nresult: %v\nexpected: %v", result, 5)
+    }
+    if _, ok := Min(New[int]()); ok {
+        t.Errorf("Min\nresult: true\nexpected: false")
+    }
+    if result, expected := list.String(), "[3<->1<->4<->1<->5]"; result != expected {
+        t.Errorf("Functions\ninput list is modified: %v", result)
+    }
+}
+
+func TestInPlaceMethods(t *testing.T) {
+    list := New(1, 2, 3)
+    if err := list.InsertAt(0, 0); err != nil {
+        t.Errorf("InsertAt\nunexpected error: %v", err)
+    }
+    if err := list.InsertAt(4, 4); err != nil {
+        t.Errorf("InsertAt\nunexpected error: %v", err)
+    }
+    if err := list.InsertAt(6, 6); !errors.Is(err, golist2.ErrIndexOutOfRange) {
+        t.Errorf("InsertAt\nresult: %v\nexpected: %v", err, golist2.ErrIndexOutOfRange)
+    }
+    if value, err := list.DeleteAt(-2); err != nil || value != 3 {
+        t.Errorf("DeleteAt\nresult: %v, %v\nexpected: %v, %v", value, err, 3, nil)
+    }
+    if err := list.ReplaceAt(1, 9); err != nil {
+        t.Errorf("ReplaceAt\nunexpected error: %v", err)
+    }
+    if err := list.UpdateAt(-1, func(x int) int { return x * 10 }); err != nil {
+        t.Errorf("UpdateAt\nunexpected error: %v", err)
+    }
+    if result, expected := list.String(), "[0<->9<->2<->40]"; result != expected {
+        t.Errorf("InsertAt\nresult: %v\nexpected: %v", result, expected)
+    }
+    if result, expected := list.RemoveFunc(func(x int) bool { return x > 5 }), 2; result != expected {
+        t.Errorf("RemoveFunc\nresult: %v\nexpected: %v", result, expected)
+    }
+    if result, expected := list.String(), "[0<->2]"; result != expected {
+        t.Errorf("RemoveFunc\nresult: %v\nexpected: %v", result, expected)
+    }
+    if err := golist2.Validate(list.Snapshot()); err != nil {
+        t.Errorf("Validate\nunexpected error: %v", err)
+    }
+
+    var empty List[int]
+    if _, err := empty.DeleteAt(0); !errors.Is(err, golist2.ErrEmptyList) {
+        t.Errorf("DeleteAt\nresult: %v\nexpected: %v", err, golist2.ErrEmptyList)
+    }
+}
+
+func TestConcurrentPush(t *testing.T) {
+    var list List[int]
+    var wg sync.WaitGroup
+    for w := 0; w < workers; w++ {
+        wg.Add(1)
+        go func(w int) {
+            defer wg.Done()
+            for i := 0; i < perWorker; i++ {
+                if i%2 == 0 {
+                    list.PushBack(w*perWorker + i)
+                } else {
+                    list.PushFront(w*perWorker + i)
+                }
+            }
+        }(w)
+    }
+    wg.Wait()
+
+    if result, expected := list.Len(), workers*perWorker; result != expected {
+        t.Errorf("Len\nresult: %v\nexpected: %v", result, expected)
+    }
+    list.Update(func(l *golist2.GoList2[int]) {
+        if err := golist2.Validate(*l); err != nil {
+            t.Errorf("Validate\nunexpected error: %v", err)
+        }
+    })
+    values := golist2.ToSlice(golist2.Sort(list.Snapshot()))
+    for i, value := range values {
+        if i != value {
+            t.Fatalf("PushBack\nresult: %v\nexpected: %v", value, i)
+        }
+    }
+}
+
+func TestConcurrentPop(t *testing.T) {
+    list := FromList(golist2.Seq(0, workers*perWorker-1, 1))
+    results := make([][]int, workers)
+    var wg sync.WaitGroup
+    for w := 0; w < workers; w++ {
+        wg.Add(1)
+        go func(w int) {
+            defer wg.Done()
+            for {
+                pop := list.PopFront
+                if w%2 == 1 {
+                    pop = list.PopBack
+                }
+                value, ok := pop()
+                if !ok {
+                    return
+                }
+                results[w] = append(results[w], value)
+            }
+        }(w)
+    }
+    wg.Wait()
+
+    values := slices.Sorted(slices.Values(slices.Concat(results...)))
+    if len(values) != workers*perWorker {
+        t.Fatalf("PopFront\nresult: %v values\nexpected: %v values", len(values), workers*perWorker)
+    }
+    for i, value := range values {
+        if i != value {
+            t.Fatalf("PopFront\nresult: %v\nexpected: %v", value, i)
+        }
+    }
+}
+
+func TestConcurrentIterate(t *testing.T) {
+    list := New(0)
+    var wg sync.WaitGroup
+    wg.Add(2)
+    go func() {
+        defer wg.Done()
+        for i := 1; i <= perWorker; i++ {
+            list.PushBack(i)
+        }
+    }()
+    go func() {
+        defer wg.Done()
+        for i := 0; i < 100; i++ {
+            previous := -1
+            for value := range list.All() {
+                if value != previous+1 {
+                    t.Errorf("All\nresult: %v\nexpected: %v", value, previous+1)
+                    return
+                }
+                previous = value
+            }
+        }
+    }()
+    wg.Wait()
+
+    index := 0
+    for i, value := range list.Enumerate() {
+        if i != value {
+            t.Fatalf("Enumerate\nresult: %v\nexpected: %v", value, i)
+        }
+        list.PushBack(-1)
+        index++
+    }
+    if index != perWorker+1 {
+        t.Errorf("Enumerate\nresult: %v\nexpected: %v", index, perWorker+1)
+    }
+    for value := range list.Backward() {
+        if value != -1 {
+            t.Errorf("Backward\nresult: %v\nexpected: %v", value, -1)
+        }
+        break
+    }
+}
+
+func TestConcurrentInsertDelete(t *testing.T) {
+    list := New[int]()
+    var wg sync.WaitGroup
+    for w := 0; w < workers; w++ {
+        wg.Add(1)
+        go func(w int) {
+            defer wg.Done()
+            for i := 0; i < perWorker; i++ {
+                // Len may shrink before InsertAt runs.
+                if list.InsertAt(list.Len()/2, w) != nil {
+                    list.PushFront(w)
+                }
+                if i%2 == 1 {
+                    list.DeleteAt(0)
+                }
+                Filter(list, func(x int) bool { return x == w })
+            }
+        }(w)
+    }
+    wg.Wait()
+
+    if result, expected := list.Len(), workers*perWorker/2; result != expected {
+        t.Errorf("Len\nresult: %v\nexpected: %v", result, expected)
+    }
+    if err := golist2.Validate(list.Snapshot()); err != nil {
+        t.Errorf("Validate\nunexpected error: %v", err)
+    }
+}
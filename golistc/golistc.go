@@ -3,11 +3,20 @@
 package golistc
 
 import (
+    "bytes"
+    "container/heap"
+    "encoding/gob"
+    "encoding/json"
+    "errors"
     "fmt"
+    "io"
+    "iter"
+    "reflect"
+    "strconv"
     "strings"
-    // "github.com/google/go-cmp/cmp"
+    "github.com/hiennguyen-neih/go-linkedlist/internal/listtext"
     "github.com/hiennguyen-neih/go-linkedlist/node"
-    // "github.com/hiennguyen-neih/go-linkedlist/constraints"
+    "github.com/hiennguyen-neih/go-linkedlist/constraints"
 )
 
 /*
@@ -22,6 +31,23 @@ type GoListC[T any] struct {
     Tail *node.Node[T]    // Last node of the list.
 }
 
+// Errors returned by the Try, Parse and Validate functions of the package.
+var (
+    ErrEmptyList       = errors.New("golistc: list is empty")
+    ErrIndexOutOfRange = errors.New("golistc: index out of range")
+    ErrNegativeLength  = errors.New("golistc: negative length")
+    ErrSyntax          = errors.New("golistc: invalid list syntax")
+    ErrCorrupted       = errors.New("golistc: corrupted list")
+)
+
+// Text format of the list, such as ["a"=>"b"=>], written by WriteTo and read by
+// ParseReader.
+var textFormat = listtext.Format{
+    Sep:       "=>",
+    Trailing:  true,
+    ErrSyntax: ErrSyntax,
+}
+
 /*
  *******************************************************************************
  * Exported functions
@@ -46,17 +72,20 @@ func FromSlice[T any](values []T) GoListC[T] {
     return list
 }
 
+// Collects values from input iterator into new singly circular linked list.
+func FromSeq[T any](seq iter.Seq[T]) GoListC[T] {
+    var list GoListC[T]
+    for val := range seq {
+        list.append(val)
+    }
+    return list
+}
+
 // Convert input singly linked list into new slice.
 func ToSlice[T any](list GoListC[T]) []T {
     var result []T
-    node := list.Head
-    for {
+    for node := list.Head; node != nil; node = list.next(node) {
         result = append(result, node.Data)
-
-        node = node.Next
-        if node == list.Head {
-            break
-        }
     }
     return result
 }
@@ -64,16 +93,10 @@ func ToSlice[T any](list GoListC[T]) []T {
 // Returns true if fun returns true for all node data in list, otherwise returns
 // false.
 func All[T any](list GoListC[T], fun func(T) bool) bool {
-    node := list.Head
-    for {
+    for node := list.Head; node != nil; node = list.next(node) {
         if !fun(node.Data) {
             return false
         }
-
-        node = node.Next
-        if node == list.Head {
-            break
-        }
     }
     return true
 }
@@ -81,16 +104,10 @@ func All[T any](list GoListC[T], fun func(T) bool) bool {
 // Returns true if fun returns true for at least 1 node data in list, otherwise
 // returns false.
 func Any[T any](list GoListC[T], fun func(T) bool) bool {
-    node := list.Head
-    for {
+    for node := list.Head; node != nil; node = list.next(node) {
         if fun(node.Data) {
             return true
         }
-
-        node = node.Next
-        if node == list.Head {
-            break
-        }
     }
     return false
 }
@@ -98,14 +115,8 @@ func Any[T any](list GoListC[T], fun func(T) bool) bool {
 // Appends values into last of input list.
 func Append[T any](list GoListC[T], values ...T) GoListC[T] {
     var result GoListC[T]
-    node := list.Head
-    for {
+    for node := list.Head; node != nil; node = list.next(node) {
         result.append(node.Data)
-
-        node = node.Next
-        if node == list.Head {
-            break
-        }
     }
     for _, value := range values {
         result.append(value)
@@ -116,101 +127,1409 @@ func Append[T any](list GoListC[T], values ...T) GoListC[T] {
 // Appends values into head of input list.
 func AppendHead[T any](list GoListC[T], values ...T) GoListC[T] {
     var result GoListC[T]
-    node := list.Head
     for _, value := range values {
         result.append(value)
     }
-
-    for {
+    for node := list.Head; node != nil; node = list.next(node) {
         result.append(node.Data)
-        node = node.Next
-        if node == list.Head {
-            break
+    }
+    return result
+}
+
+// Collects values from input iterator into new list. This function is the
+// same as FromSeq, named after slices.Collect.
+func Collect[T any](seq iter.Seq[T]) GoListC[T] {
+    return FromSeq(seq)
+}
+
+// Returns a list that is concatenated of all input lists.
+func Concat[T any](lists ...GoListC[T]) GoListC[T] {
+    var result GoListC[T]
+    for _, list := range lists {
+        for node := list.Head; node != nil; node = list.next(node) {
+            result.append(node.Data)
         }
     }
     return result
 }
 
-// Returns a list containing the nodes of input list in reverse order.
-func Reverse[T any](list GoListC[T]) GoListC[T] {
-    var head *node.Node[T]
-    for {
-        curr := list.Head
-        node := &node.Node[T]{Data: curr.Data, Next: head}
-        head = node
+// Returns a copy of input list where the first node data that matching value
+// is removed. Node data are compared with ==, see DeleteFunc for other cases.
+func Delete[T comparable](list GoListC[T], value T) GoListC[T] {
+    return DeleteFunc(list, func(data T) bool { return data == value })
+}
 
-        curr = curr.Next
-        if curr == list.Head {
+// Returns a copy of input list where the first node that fun returns true is
+// removed.
+func DeleteFunc[T any](list GoListC[T], fun func(T) bool) GoListC[T] {
+    var result GoListC[T]
+    node := list.Head
+    for node != nil {
+        if !fun(node.Data) {
+            result.append(node.Data)
+            node = list.next(node)
+        } else {
+            node = list.next(node)
             break
         }
     }
-    return GoListC[T]{Head: head}
+    for node != nil {
+        result.append(node.Data)
+        node = list.next(node)
+    }
+    return result
 }
 
-/*
- *******************************************************************************
- * Exported methods
- *******************************************************************************
- */
+// Deletes node at the specific index of list. index wraps around the list, so
+// any index addresses a node of a non-empty list. Negative index indicate an
+// offset from the end of list. If input list is an empty list, returns an
+// empty list. See TryDeleteAt for a variant returning an error.
+func DeleteAt[T any](list GoListC[T], index int) GoListC[T] {
+    result, _ := TryDeleteAt(list, index)
+    return result
+}
 
-// Returns a string representing the singly linked list.
-func (list GoListC[T]) String() string {
-    var builder strings.Builder
-    builder.WriteString("[")
+// Returns a list of distinct nodes data of list1 that are not in list2, in
+// order of their first occurrence in list1. Unlike Subtract, duplicates are
+// removed from the result.
+func Difference[T comparable](list1, list2 GoListC[T]) GoListC[T] {
+    set2 := toSet(list2)
+    return Uniq(Filter(list1, func(data T) bool {
+        _, found := set2[data]
+        return !found
+    }))
+}
+
+// Drops the last node of input list. If input list is an empty list, returns
+// an empty list.
+func DropLast[T any](list GoListC[T]) GoListC[T] {
+    var result GoListC[T]
+    for node := list.Head; node != nil && node != list.Tail; node = node.Next {
+        result.append(node.Data)
+    }
+    return result
+}
+
+// Drops nodes from list while fun returns true.
+func DropWhile[T any](list GoListC[T], fun func(T) bool) GoListC[T] {
+    var result GoListC[T]
     node := list.Head
-    for {
-        var data any = node.Data
-        if str, ok := data.(string); ok {
-            fmt.Fprintf(&builder, "%q", str)
+    for node != nil {
+        if !fun(node.Data) {
+            break
+        }
+        node = list.next(node)
+    }
+    for node != nil {
+        result.append(node.Data)
+        node = list.next(node)
+    }
+    return result
+}
+
+// Returns a list containing n copies of term elem. If n is negative or equal
+// 0, return empty list.
+func Duplicate[T any](n int, elem T) GoListC[T] {
+    var result GoListC[T]
+    for i := 0; i < n; i++ {
+        result.append(elem)
+    }
+    return result
+}
+
+// Returns true if all corresponding nodes in both list1 and list2 have the
+// same value, otherwise return false. Node data are compared with ==, see
+// EqualFunc for other cases.
+func Equal[T comparable](list1, list2 GoListC[T]) bool {
+    return EqualFunc(list1, list2, func(data1, data2 T) bool { return data1 == data2 })
+}
+
+// Returns true if list1 and list2 have the same length and eq returns true for
+// all corresponding nodes data, otherwise returns false.
+func EqualFunc[T1, T2 any](list1 GoListC[T1], list2 GoListC[T2], eq func(T1, T2) bool) bool {
+    node2 := list2.Head
+    for node1 := list1.Head; node1 != nil; node1 = list1.next(node1) {
+        if node2 == nil || !eq(node1.Data, node2.Data) {
+            return false
+        }
+        node2 = list2.next(node2)
+    }
+    if node2 != nil {
+        return false
+    }
+    return true
+}
+
+// Returns a list contains node data from input list for which fun returns true.
+func Filter[T any](list GoListC[T], fun func(T) bool) GoListC[T] {
+    var result GoListC[T]
+    for node := list.Head; node != nil; node = list.next(node) {
+        if fun(node.Data) {
+            result.append(node.Data)
+        }
+    }
+    return result
+}
+
+// Calls fun on successive nodes of list to update or remove nodes from list.
+// Input fun must return (bool, value). The functions returns a list that nodes
+// data are value in which fun returns (true, value). Type of value may differ
+// from type of input list data.
+func FilterMap[T1, T2 any](list GoListC[T1], fun func(T1) (bool, T2)) GoListC[T2] {
+    var result GoListC[T2]
+    for node := list.Head; node != nil; node = list.next(node) {
+        if keep, value := fun(node.Data); keep {
+            result.append(value)
+        }
+    }
+    return result
+}
+
+// Returns position of first node of list that match with value. If there is
+// no matching node, returns -1. Node data are compared with ==, see IndexFunc
+// for other cases.
+func Find[T comparable](list GoListC[T], value T) int {
+    return IndexFunc(list, func(data T) bool { return data == value })
+}
+
+// Calls fun(data) to every nodes in list and returns a list that is
+// concatenated of all lists returned by that fun. Type of returned lists data
+// may differ from type of input list data.
+func FlatMap[T1, T2 any](list GoListC[T1], fun func(T1) GoListC[T2]) GoListC[T2] {
+    var result GoListC[T2]
+    for node := list.Head; node != nil; node = list.next(node) {
+        mapped := fun(node.Data)
+        for sub := mapped.Head; sub != nil; sub = mapped.next(sub) {
+            result.append(sub.Data)
+        }
+    }
+    return result
+}
+
+// Calls fun(data, acc) on successive nodes of list from left to right (from
+// head of list to tail of list), starting with acc0. Input fun must return a
+// new accumulator, which is passed to the next call. The function returns the
+// final value of the accumulator. Input acc0 is returned if the list is empty.
+func Foldl[T1, T2 any](list GoListC[T1], acc0 T2, fun func(T1, T2) T2) T2 {
+    for node := list.Head; node != nil; node = list.next(node) {
+        acc0 = fun(node.Data, acc0)
+    }
+    return acc0
+}
+
+// Calls fun(data, acc) on successive nodes of list from right to left (from
+// tail of list to head of list), starting with acc0. Input fun must return a
+// new accumulator, which is passed to the next call. The function returns the
+// final value of the accumulator. Input acc0 is returned if the list is empty.
+func Foldr[T1, T2 any](list GoListC[T1], acc0 T2, fun func(T1, T2) T2) T2 {
+    reverse := Reverse(list)
+    for node := reverse.Head; node != nil; node = reverse.next(node) {
+        acc0 = fun(node.Data, acc0)
+    }
+    return acc0
+}
+
+// Calls fun(data) for each node in list, ignoring the return value. This
+// function is used for its side effects and the evaluation order is defined
+// to be the same as the order of the nodes in the list, from head to tail.
+func ForEach[T any](list GoListC[T], fun func(T)) {
+    for node := list.Head; node != nil; node = list.next(node) {
+        fun(node.Data)
+    }
+}
+
+// Returns position of first node of list that fun returns true. If every fun
+// execution returns false, returns -1.
+func IndexFunc[T any](list GoListC[T], fun func(T) bool) int {
+    i := 0
+    for node := list.Head; node != nil; node = list.next(node) {
+        if fun(node.Data) {
+            return i
+        }
+        i++
+    }
+    return -1
+}
+
+// Returns a list with val is inserted at specific index. index is capped at
+// list length. Negative index indicate an offset from the end of list. Panics
+// if index is out of bound, see TryInsertAt for a variant returning an error.
+func InsertAt[T any](list GoListC[T], index int, val T) GoListC[T] {
+    result, err := TryInsertAt(list, index, val)
+    if err != nil {
+        panic("InsertAt, index is out of bound!")
+    }
+    return result
+}
+
+// Returns a list of distinct nodes data of list1 that are also in list2, in
+// order of their first occurrence in list1.
+func Intersect[T comparable](list1, list2 GoListC[T]) GoListC[T] {
+    set2 := toSet(list2)
+    return Uniq(Filter(list1, func(data T) bool {
+        _, found := set2[data]
+        return found
+    }))
+}
+
+// Inserts sep between each node in list. This function has no effect on an
+// empty list or a singleton list.
+func Join[T any](list GoListC[T], sep T) GoListC[T] {
+    var result GoListC[T]
+    for node := list.Head; node != nil; node = list.next(node) {
+        result.append(node.Data)
+        if node != list.Tail {
+            result.append(sep)
+        }
+    }
+    return result
+}
+
+// Returns the last node in list, which is the Tail of list.
+func Last[T any](list GoListC[T]) *node.Node[T] {
+    return list.Tail
+}
+
+// Returns the length of list.
+func Len[T any](list GoListC[T]) int {
+    len := 0
+    for node := list.Head; node != nil; node = list.next(node) {
+        len += 1
+    }
+    return len
+}
+
+// Calls fun(data) to every nodes in list and returns a list contains returned
+// values of that fun. Returned values may have a different type from input
+// list data.
+func Map[T1, T2 any](list GoListC[T1], fun func(T1) T2) GoListC[T2] {
+    var result GoListC[T2]
+    for node := list.Head; node != nil; node = list.next(node) {
+        result.append(fun(node.Data))
+    }
+    return result
+}
+
+// Combines the operations of Map function and Foldl function into one pass.
+// Mapped values may have a different type from input list data.
+func MapFoldl[T1, T2, T3 any](list GoListC[T1], acc0 T2, fun func(T1, T2) (T3, T2)) (GoListC[T3], T2) {
+    var value T3
+    var result GoListC[T3]
+    for node := list.Head; node != nil; node = list.next(node) {
+        value, acc0 = fun(node.Data, acc0)
+        result.append(value)
+    }
+    return result, acc0
+}
+
+// Combines the operations of Map function and Foldr function into one pass.
+// Mapped values may have a different type from input list data.
+func MapFoldr[T1, T2, T3 any](list GoListC[T1], acc0 T2, fun func(T1, T2) (T3, T2)) (GoListC[T3], T2) {
+    var value T3
+    var result GoListC[T3]
+    reverse := Reverse(list)
+    for node := reverse.Head; node != nil; node = reverse.next(node) {
+        value, acc0 = fun(node.Data, acc0)
+        result.appendHead(value)
+    }
+    return result, acc0
+}
+
+// Returns the first node in list that compares greater than or equal to all
+// other nodes of list. This function only works with constraint Ordered list.
+func Max[T constraints.Ordered](list GoListC[T]) *node.Node[T] {
+    max := list.Head
+    for node := list.Head; node != nil; node = list.next(node) {
+        if node.Data > max.Data {
+            max = node
+        }
+    }
+    return max
+}
+
+// Returns the first node in list that compares greater than or equal to all
+// other nodes of list, using cmp to compare node data. cmp(a, b) should return
+// a negative number when a < b, a positive number when a > b and zero when
+// a == b. Returns nil if list is empty.
+func MaxFunc[T any](list GoListC[T], cmp func(a, b T) int) *node.Node[T] {
+    max := list.Head
+    for node := list.Head; node != nil; node = list.next(node) {
+        if cmp(node.Data, max.Data) > 0 {
+            max = node
+        }
+    }
+    return max
+}
+
+// Returns true if elem matches some node data of list, otherwise returns false.
+func Member[T comparable](list GoListC[T], elem T) bool {
+    return Find(list, elem) != -1
+}
+
+// Returns a sorted list forming by merging all input lists. When all input
+// lists are sorted, they are merged in linear time. This function only works
+// with constraint Ordered lists.
+func Merge[T constraints.Ordered](lists ...GoListC[T]) GoListC[T] {
+    return MergeFunc(compare[T], lists...)
+}
+
+// Returns a sorted list forming by merging all input lists, using cmp to
+// compare node data. When all input lists are sorted, they are merged in
+// linear time. Nodes that compare equal keep their input order.
+func MergeFunc[T any](cmp func(a, b T) int, lists ...GoListC[T]) GoListC[T] {
+    if !allSortedFunc(lists, cmp) {
+        return SortStableFunc(Concat(lists...), cmp)
+    }
+    return mergeFunc(lists, cmp, false)
+}
+
+// Returns the first node in list that compares less than or equal to all
+// other nodes of list. This function only works with constraint Ordered list.
+func Min[T constraints.Ordered](list GoListC[T]) *node.Node[T] {
+    min := list.Head
+    for node := list.Head; node != nil; node = list.next(node) {
+        if node.Data < min.Data {
+            min = node
+        }
+    }
+    return min
+}
+
+// Returns the first node in list that compares less than or equal to all
+// other nodes of list, using cmp to compare node data. Returns nil if list is
+// empty.
+func MinFunc[T any](list GoListC[T], cmp func(a, b T) int) *node.Node[T] {
+    min := list.Head
+    for node := list.Head; node != nil; node = list.next(node) {
+        if cmp(node.Data, min.Data) < 0 {
+            min = node
+        }
+    }
+    return min
+}
+
+// Returns node in list at specific index. index wraps around the list, so
+// Nth(list, Len(list)) is the Head of list again. Negative index indicate an
+// offset from the end of list. Panics if list is empty, see TryNth for a
+// variant returning an error.
+func Nth[T any](list GoListC[T], index int) *node.Node[T] {
+    node, err := TryNth(list, index)
+    if err != nil {
+        panic("Nth, list is empty!")
+    }
+    return node
+}
+
+// Returns sublist from node in list at specific index to the tail of list.
+// index wraps around the list. Negative index indicate an offset from the end
+// of list. Panics if list is empty, see TryNthTail for a variant returning an
+// error.
+func NthTail[T any](list GoListC[T], index int) GoListC[T] {
+    result, err := TryNthTail(list, index)
+    if err != nil {
+        panic("NthTail, list is empty!")
+    }
+    return result
+}
+
+// Parses str in the format produced by String method, such as [1=>2=>], into a
+// new list. Each node data is decoded from its text by decode. Returns an
+// error wrapping ErrSyntax if str is not in that format, or the error returned
+// by decode.
+func Parse[T any](str string, decode func(string) (T, error)) (GoListC[T], error) {
+    return ParseReader(strings.NewReader(str), decode)
+}
+
+// Parses str into a new list of numbers. Same as Parse, with node data decoded
+// as they are formatted by %v.
+func ParseNumbers[T constraints.Numeric](str string) (GoListC[T], error) {
+    return Parse(str, func(token string) (T, error) {
+        return listtext.ParseNumber[T](token, ErrSyntax)
+    })
+}
+
+// Same as Parse, but reading the text from r. The reader is consumed
+// incrementally, so the whole text is never held in memory. Only white
+// spaces may follow the closing bracket.
+func ParseReader[T any](r io.Reader, decode func(string) (T, error)) (GoListC[T], error) {
+    var result GoListC[T]
+    add := func(value T) { result.append(value) }
+    if err := listtext.Read(textFormat, r, decode, add); err != nil {
+        return GoListC[T]{}, err
+    }
+    return result, nil
+}
+
+// Parses str into a new list of strings. Same as Parse, with node data decoded
+// as Go quoted strings, which is how String method formats them.
+func ParseStrings(str string) (GoListC[string], error) {
+    return Parse(str, strconv.Unquote)
+}
+
+// Partitions input list into list1 and list2, where list1 contains nodes
+// which fun returns true and list2 contains nodes which fun returns false.
+func Partition[T any](list GoListC[T], fun func(T) bool) (GoListC[T], GoListC[T]) {
+    var list1 GoListC[T]
+    var list2 GoListC[T]
+    for node := list.Head; node != nil; node = list.next(node) {
+        if fun(node.Data) {
+            list1.append(node.Data)
         } else {
-            fmt.Fprintf(&builder, "%v", node.Data)
+            list2.append(node.Data)
         }
-        builder.WriteString("=>")
+    }
+    return list1, list2
+}
 
-        node = node.Next
-        if node == list.Head {
-            break
+// Returns true if list1 is a prefix of list2, otherwise returns false.
+// A prefix of a list is the first part of the list, starting from the
+// head and stopping at any point.
+func Prefix[T comparable](list1, list2 GoListC[T]) bool {
+    node1 := list1.Head
+    node2 := list2.Head
+    for node1 != nil {
+        if node2 == nil || node1.Data != node2.Data {
+            return false
         }
+        node1 = list1.next(node1)
+        node2 = list2.next(node2)
     }
-    builder.WriteString("]")
-    return builder.String()
+    return true
 }
 
-/*
- *******************************************************************************
- * Internal functions and methods
- *******************************************************************************
- */
+// Returns a list that node at specific index is replaced with val. index
+// wraps around the list. Negative index indicate an offset from the end of
+// list. If input list is an empty list, returns an empty list. See
+// TryReplaceAt for a variant returning an error.
+func ReplaceAt[T any](list GoListC[T], index int, val T) GoListC[T] {
+    result, _ := TryReplaceAt(list, index, val)
+    return result
+}
 
-// Do append value into head of list.
-func (list *GoListC[T]) append(value T) *GoListC[T] {
-    node := &node.Node[T]{Data: value}
-    if list.Head != nil {
-        node.Next = list.Head
-        list.Tail.Next = node
-        list.Tail = node
-    } else {
-        list.Head = node
-        list.Tail = node
-        node.Next = node
+// Returns a list containing the nodes of input list in reverse order.
+func Reverse[T any](list GoListC[T]) GoListC[T] {
+    var result GoListC[T]
+    for node := list.Head; node != nil; node = list.next(node) {
+        result.appendHead(node.Data)
     }
-    return list
+    return result
 }
 
-// Do reverse the list.
-func (list *GoListC[T]) reverse() *GoListC[T] {
-    prev := list.Tail
-    node := list.Head
-    for {
-        next := node.Next
-        node.Next = prev
-        prev = node
-        node = next
-        if node == list.Head {
+// Returns a list that is rotated by n nodes, so that the node at index n of
+// input list becomes the Head of the result. n wraps around the list. Negative
+// n rotates the list in the opposite direction.
+func Rotate[T any](list GoListC[T], n int) GoListC[T] {
+    var result GoListC[T]
+    if list.Head == nil {
+        return result
+    }
+
+    start := Nth(list, n)
+    for node := start; ; {
+        result.append(node.Data)
+        node = node.Next
+        if node == start {
             break
         }
     }
-    list.Head = prev
-    list.Tail = node
+    return result
+}
+
+// Returns position and first node in list that fun returns true. If every fun
+// execution returns false, returns position is -1.
+func Search[T any](list GoListC[T], fun func(T) bool) (int, *node.Node[T]) {
+    var zero *node.Node[T]
+    i := 0
+    for node := list.Head; node != nil; node = list.next(node) {
+        if fun(node.Data) {
+            return i, node
+        }
+        i++
+    }
+    return -1, zero
+}
+
+// Returns sequence of numbers that starts with from and contains the
+// successive results of adding incr to the previous node data, until to is
+// reached or passed (in later case, to is not an node data of the sequence).
+func Seq[T constraints.Numeric](from, to, incr T) GoListC[T] {
+    var result GoListC[T]
+    for i := from; i <= to; i += incr {
+        result.append(i)
+    }
+    return result
+}
+
+// Returns a list containing the sorted nodes data of input list. This function
+// only works with constraint Ordered list.
+func Sort[T constraints.Ordered](list GoListC[T]) GoListC[T] {
+    return SortStableFunc(list, compare[T])
+}
+
+// Returns a list containing the nodes data of input list sorted in ascending
+// order as determined by cmp. cmp(a, b) should return a negative number when
+// a < b, a positive number when a > b and zero when a == b.
+func SortFunc[T any](list GoListC[T], cmp func(a, b T) int) GoListC[T] {
+    return SortStableFunc(list, cmp)
+}
+
+// Same as SortFunc, but keeping the original order of nodes that compare
+// equal.
+func SortStableFunc[T any](list GoListC[T], cmp func(a, b T) int) GoListC[T] {
+    result := Concat(list)
+    return *result.mergeSort(cmp)
+}
+
+// Split input list into list1 and list2, list1 contains n first nodes and
+// list2 contains the remaining nodes. n is capped at list length. Negative
+// n indicate an offset from the end of list. Panics if n is out of bound, see
+// TrySplit for a variant returning an error.
+func Split[T any](list GoListC[T], n int) (GoListC[T], GoListC[T]) {
+    list1, list2, err := TrySplit(list, n)
+    if err != nil {
+        panic("Split, n is out of bound!")
+    }
+    return list1, list2
+}
+
+// Split input list into list1 and list2, where list1 behave as
+// TakeWhile(fun, list) and list2 behave as DropWhile(fun, list).
+func SplitWith[T any](list GoListC[T], fun func(T) bool) (GoListC[T], GoListC[T]) {
+    var list1 GoListC[T]
+    var list2 GoListC[T]
+    node := list.Head
+    for node != nil {
+        if fun(node.Data) {
+            list1.append(node.Data)
+        } else {
+            break
+        }
+        node = list.next(node)
+    }
+    for node != nil {
+        list2.append(node.Data)
+        node = list.next(node)
+    }
+    return list1, list2
+}
+
+// Returns sublist of input list, starting at start and has maximum len nodes.
+// start wraps around the list. Negative start indicate an offset from the end
+// of list. len must be a non-negative integer. When start + len exceeds the
+// length of list, the sublist continues from the Head of list, but it never
+// contains more nodes than input list. Panics if list is empty or len is
+// negative, see TrySublist for a variant returning an error.
+func Sublist[T any](list GoListC[T], start, len int) GoListC[T] {
+    result, err := TrySublist(list, start, len)
+    if err == ErrNegativeLength {
+        panic("Sublist, input len must not be negative!")
+    } else if err != nil {
+        panic("Sublist, list is empty!")
+    }
+    return result
+}
+
+// Returns a new list that is a copy of list1 which is for each node data in
+// list2, its first occurrence in list1 is deleted. Node data are compared with
+// ==, see SubtractFunc for other cases.
+func Subtract[T comparable](list1, list2 GoListC[T]) GoListC[T] {
+    counts := make(map[T]int) // number of occurrences to delete
+    for node2 := list2.Head; node2 != nil; node2 = list2.next(node2) {
+        counts[node2.Data]++
+    }
+
+    var result GoListC[T]
+    for node1 := list1.Head; node1 != nil; node1 = list1.next(node1) {
+        if counts[node1.Data] > 0 {
+            counts[node1.Data]--
+            continue
+        }
+        result.append(node1.Data)
+    }
+    return result
+}
+
+// Returns a new list that is a copy of list1 which is for each node data in
+// list2, its first occurrence in list1 that eq returns true is deleted.
+func SubtractFunc[T any](list1, list2 GoListC[T], eq func(T, T) bool) GoListC[T] {
+    result := Concat(list1)
+    for node2 := list2.Head; node2 != nil; node2 = list2.next(node2) {
+        index := IndexFunc(result, func(data T) bool { return eq(data, node2.Data) })
+        if index != -1 {
+            result = DeleteAt(result, index)
+        }
+    }
+    return result
+}
+
+// Returns true if list1 is a suffix of list2, otherwise returns false.
+// A suffix of a list if the last part of the list, starting from any position
+// and going all the way to the tail.
+func Suffix[T comparable](list1, list2 GoListC[T]) bool {
+    reverse1 := Reverse(list1)
+    reverse2 := Reverse(list2)
+    return Prefix(reverse1, reverse2)
+}
+
+// Returns a list of distinct nodes data that are in exactly one of list1 and
+// list2. Nodes data of list1 come first, each in order of first occurrence.
+func SymmetricDifference[T comparable](list1, list2 GoListC[T]) GoListC[T] {
+    return Concat(Difference(list1, list2), Difference(list2, list1))
+}
+
+// Returns sum of all nodes data in list. This function only works with
+// constraint Ordered list.
+func Sum[T constraints.Ordered](list GoListC[T]) T {
+    var sum T
+    for node := list.Head; node != nil; node = list.next(node) {
+        sum += node.Data
+    }
+    return sum
+}
+
+// Takes nodes data in list while fun returns true, returning the longest
+// prefix in which all nodes data satisfy the predicate.
+func TakeWhile[T any](list GoListC[T], fun func(T) bool) GoListC[T] {
+    var result GoListC[T]
+    for node := list.Head; node != nil; node = list.next(node) {
+        if fun(node.Data) {
+            result.append(node.Data)
+        } else {
+            break
+        }
+    }
+    return result
+}
+
+// Deletes node at the specific index of list. index wraps around the list.
+// Negative index indicate an offset from the end of list. Returns ErrEmptyList
+// if list is empty.
+func TryDeleteAt[T any](list GoListC[T], index int) (GoListC[T], error) {
+    var result GoListC[T]
+    if list.Head == nil {
+        return result, ErrEmptyList
+    }
+
+    index = wrap(index, Len(list))
+    i := 0
+    for node := list.Head; node != nil; node = list.next(node) {
+        if i != index {
+            result.append(node.Data)
+        }
+        i++
+    }
+    return result, nil
+}
+
+// Returns a list with val is inserted at specific index. Negative index
+// indicate an offset from the end of list. Returns ErrIndexOutOfRange if index
+// is out of bound.
+func TryInsertAt[T any](list GoListC[T], index int, val T) (GoListC[T], error) {
+    len := Len(list)
+    if index < 0 {
+        index = len + index // same as len - abs(index)
+    }
+    if index < 0 || index > len {
+        return GoListC[T]{}, ErrIndexOutOfRange
+    }
+
+    var result GoListC[T]
+    i := 0
+    for node := list.Head; node != nil; node = list.next(node) {
+        if i == index {
+            result.append(val)
+        }
+        result.append(node.Data)
+        i++
+    }
+    if index == len {
+        result.append(val)
+    }
+    return result, nil
+}
+
+// Returns node in list at specific index. index wraps around the list.
+// Negative index indicate an offset from the end of list. Returns ErrEmptyList
+// if list is empty.
+func TryNth[T any](list GoListC[T], index int) (*node.Node[T], error) {
+    if list.Head == nil {
+        return nil, ErrEmptyList
+    }
+
+    node := list.Head
+    for i := wrap(index, Len(list)); i > 0; i-- {
+        node = node.Next
+    }
+    return node, nil
+}
+
+// Returns sublist from node in list at specific index to the tail of list.
+// index wraps around the list. Negative index indicate an offset from the end
+// of list. Returns ErrEmptyList if list is empty.
+func TryNthTail[T any](list GoListC[T], index int) (GoListC[T], error) {
+    nth, err := TryNth(list, index)
+    if err != nil {
+        return GoListC[T]{}, err
+    }
+
+    var result GoListC[T]
+    for node := nth; node != nil; node = list.next(node) {
+        result.append(node.Data)
+    }
+    return result, nil
+}
+
+// Returns a list that node at specific index is replaced with val. index
+// wraps around the list. Negative index indicate an offset from the end of
+// list. Returns ErrEmptyList if list is empty.
+func TryReplaceAt[T any](list GoListC[T], index int, val T) (GoListC[T], error) {
+    return TryUpdateAt(list, index, func(T) T { return val })
+}
+
+// Split input list into list1 and list2, list1 contains n first nodes and
+// list2 contains the remaining nodes. Negative n indicate an offset from the
+// end of list. Returns ErrEmptyList if list is empty and ErrIndexOutOfRange if
+// n is out of bound.
+func TrySplit[T any](list GoListC[T], n int) (GoListC[T], GoListC[T], error) {
+    var list1 GoListC[T]
+    var list2 GoListC[T]
+    len := Len(list)
+    if len == 0 {
+        return list1, list2, ErrEmptyList
+    }
+    if n < 0 {
+        n = len + n // same as len - abs(n)
+    }
+    if n < 0 || n >= len {
+        return list1, list2, ErrIndexOutOfRange
+    }
+
+    node := list.Head
+    for i := 0; i < n; i++ {
+        list1.append(node.Data)
+        node = node.Next
+    }
+    for node != nil {
+        list2.append(node.Data)
+        node = list.next(node)
+    }
+    return list1, list2, nil
+}
+
+// Returns sublist of input list, starting at start and has maximum len nodes.
+// start wraps around the list. Negative start indicate an offset from the end
+// of list. The sublist continues from the Head of list when start + len
+// exceeds the length of list, but it never contains more nodes than input
+// list. Returns ErrNegativeLength if len is negative and ErrEmptyList if list
+// is empty.
+func TrySublist[T any](list GoListC[T], start, len int) (GoListC[T], error) {
+    if len < 0 {
+        return GoListC[T]{}, ErrNegativeLength
+    }
+    nth, err := TryNth(list, start)
+    if err != nil {
+        return GoListC[T]{}, err
+    }
+
+    listLen := Len(list)
+    if len > listLen {
+        len = listLen
+    }
+
+    var result GoListC[T]
+    node := nth
+    for j := 0; j < len; j++ {
+        result.append(node.Data)
+        node = node.Next
+    }
+    return result, nil
+}
+
+// Returns a list that node at specific index is updated with returns value of
+// fun. index wraps around the list. Negative index indicate an offset from the
+// end of list. Returns ErrEmptyList if list is empty.
+func TryUpdateAt[T any](list GoListC[T], index int, fun func(T) T) (GoListC[T], error) {
+    var result GoListC[T]
+    if list.Head == nil {
+        return result, ErrEmptyList
+    }
+
+    index = wrap(index, Len(list))
+    i := 0
+    for node := list.Head; node != nil; node = list.next(node) {
+        if i == index {
+            result.append(fun(node.Data))
+        } else {
+            result.append(node.Data)
+        }
+        i++
+    }
+    return result, nil
+}
+
+// Returns a list of distinct nodes data of list1 and list2, in order of their
+// first occurrence in list1 and then in list2.
+func Union[T comparable](list1, list2 GoListC[T]) GoListC[T] {
+    return Uniq(Concat(list1, list2))
+}
+
+// Returns a copy of list keeping only the first occurrence of each node data.
+// Unlike USort, the order of nodes is preserved.
+func Uniq[T comparable](list GoListC[T]) GoListC[T] {
+    return UniqBy(list, func(data T) T { return data })
+}
+
+// Returns a copy of list keeping only the first node for each key returned by
+// fun. The order of nodes is preserved.
+func UniqBy[T any, K comparable](list GoListC[T], fun func(T) K) GoListC[T] {
+    var result GoListC[T]
+    seen := make(map[K]bool) // store already seen keys into map
+    for node := list.Head; node != nil; node = list.next(node) {
+        key := fun(node.Data)
+        if seen[key] {
+            continue
+        }
+        seen[key] = true
+        result.append(node.Data)
+    }
+    return result
+}
+
+// Returns a sorted list formed by merging all input lists, while removing
+// duplicates. When all input lists are sorted, they are merged in linear
+// time. This function only works with constraint Ordered lists.
+func UMerge[T constraints.Ordered](lists ...GoListC[T]) GoListC[T] {
+    if !allSortedFunc(lists, compare[T]) {
+        return USortFunc(Concat(lists...), compare[T])
+    }
+    return mergeFunc(lists, compare[T], true)
+}
+
+// Returns a sorted list of the nodes data of list, keeping only the first
+// occurrence of nodes that compare equal and removing duplicates. This
+// function only works with constraint Ordered list.
+func USort[T constraints.Ordered](list GoListC[T]) GoListC[T] {
+    return USortFunc(list, compare[T])
+}
+
+// Returns a list containing the nodes data of input list sorted as determined
+// by cmp, keeping only the first occurrence of nodes that compare equal.
+func USortFunc[T any](list GoListC[T], cmp func(a, b T) int) GoListC[T] {
+    var result GoListC[T]
+    sorted := SortStableFunc(list, cmp)
+    for node := sorted.Head; node != nil; node = sorted.next(node) {
+        if result.Head == nil || cmp(node.Data, result.Tail.Data) != 0 {
+            result.append(node.Data)
+        }
+    }
+    return result
+}
+
+// Returns a list that node at specific index is updated with returns value of
+// fun. index wraps around the list. Negative index indicate an offset from the
+// end of list. If input list is an empty list, returns an empty list. See
+// TryUpdateAt for a variant returning an error.
+func UpdateAt[T any](list GoListC[T], index int, fun func(T) T) GoListC[T] {
+    result, _ := TryUpdateAt(list, index, fun)
+    return result
+}
+
+// Checks that list is well formed: the Next pointers from Head form a cycle
+// returning to Head, and Tail is the node before Head. Returns an error
+// wrapping ErrCorrupted that describes the first problem found, or nil.
+func Validate[T any](list GoListC[T]) error {
+    if list.Head == nil {
+        if list.Tail != nil {
+            return fmt.Errorf("%w: Tail of empty list is not nil", ErrCorrupted)
+        }
+        return nil
+    }
+    start := cycleStart(list.Head)
+    if start == nil {
+        return fmt.Errorf("%w: Next pointers do not return to Head", ErrCorrupted)
+    } else if start != list.Head {
+        return fmt.Errorf("%w: cycle starts at node %d instead of Head",
+                          ErrCorrupted, nodePosition(list.Head, start))
+    }
+    last := list.Head
+    for last.Next != list.Head {
+        last = last.Next
+    }
+    if list.Tail != last {
+        return fmt.Errorf("%w: Tail is not the last node", ErrCorrupted)
+    }
+    return nil
+}
+
+/*
+ *******************************************************************************
+ * Exported methods
+ *******************************************************************************
+ */
+
+// Returns an iterator over node data of list, from head to tail.
+func (list GoListC[T]) All() iter.Seq[T] {
+    return func(yield func(T) bool) {
+        for node := list.Head; node != nil; node = list.next(node) {
+            if !yield(node.Data) {
+                return
+            }
+        }
+    }
+}
+
+// Returns an iterator that walks around list endlessly, starting from head.
+// The iteration only stops when the loop breaks or when list is empty.
+func (list GoListC[T]) Cycle() iter.Seq[T] {
+    return func(yield func(T) bool) {
+        if list.Head == nil {
+            return
+        }
+        for node := list.Head; ; node = node.Next {
+            if !yield(node.Data) {
+                return
+            }
+        }
+    }
+}
+
+// Returns an iterator over index and node data pairs of list, from head to
+// tail.
+func (list GoListC[T]) Enumerate() iter.Seq2[int, T] {
+    return func(yield func(int, T) bool) {
+        i := 0
+        for node := list.Head; node != nil; node = list.next(node) {
+            if !yield(i, node.Data) {
+                return
+            }
+            i++
+        }
+    }
+}
+
+// Returns an iterator over nodes of list, from head to tail.
+func (list GoListC[T]) Nodes() iter.Seq[*node.Node[T]] {
+    return func(yield func(*node.Node[T]) bool) {
+        for node := list.Head; node != nil; node = list.next(node) {
+            if !yield(node) {
+                return
+            }
+        }
+    }
+}
+
+// Encodes list as a gob stream of its length followed by every node data,
+// from head to tail. Returns the error of Validate if list is corrupted, so a
+// broken ring is never walked. Implements encoding.BinaryMarshaler.
+func (list GoListC[T]) MarshalBinary() ([]byte, error) {
+    if err := Validate(list); err != nil {
+        return nil, err
+    }
+    var buffer bytes.Buffer
+    encoder := gob.NewEncoder(&buffer)
+    if err := encoder.Encode(Len(list)); err != nil {
+        return nil, err
+    }
+    for node := list.Head; node != nil; node = list.next(node) {
+        if err := encoder.Encode(node.Data); err != nil {
+            return nil, err
+        }
+    }
+    return buffer.Bytes(), nil
+}
+
+// Decodes data produced by MarshalBinary into list, replacing its nodes.
+// Implements encoding.BinaryUnmarshaler.
+func (list *GoListC[T]) UnmarshalBinary(data []byte) error {
+    decoder := gob.NewDecoder(bytes.NewReader(data))
+    var len int
+    if err := decoder.Decode(&len); err != nil {
+        return err
+    }
+    if len < 0 {
+        return fmt.Errorf("golistc: invalid encoded length %d", len)
+    }
+
+    var result GoListC[T]
+    for i := 0; i < len; i++ {
+        var value T
+        if err := decoder.Decode(&value); err != nil {
+            return err
+        }
+        result.append(value)
+    }
+    *list = result
+    return nil
+}
+
+// Same as MarshalBinary. Implements gob.GobEncoder.
+func (list GoListC[T]) GobEncode() ([]byte, error) {
+    return list.MarshalBinary()
+}
+
+// Same as UnmarshalBinary. Implements gob.GobDecoder.
+func (list *GoListC[T]) GobDecode(data []byte) error {
+    return list.UnmarshalBinary(data)
+}
+
+// Encodes list as a flat JSON array of node data. An empty list is encoded as
+// an empty array. Implements json.Marshaler.
+func (list GoListC[T]) MarshalJSON() ([]byte, error) {
+    values := make([]T, 0, Len(list))
+    for node := list.Head; node != nil; node = list.next(node) {
+        values = append(values, node.Data)
+    }
+    return json.Marshal(values)
+}
+
+// Decodes a JSON array into list, replacing its nodes. JSON null decodes into
+// an empty list. Implements json.Unmarshaler.
+func (list *GoListC[T]) UnmarshalJSON(data []byte) error {
+    var values []T
+    if err := json.Unmarshal(data, &values); err != nil {
+        return err
+    }
+    *list = FromSlice(values)
+    return nil
+}
+
+// Returns a string representing the singly circular linked list.
+func (list GoListC[T]) String() string {
+    var builder strings.Builder
+    list.WriteTo(&builder)
+    return builder.String()
+}
+
+// Writes the same text as String method into w, node by node, without
+// building the whole string in memory. Returns the number of bytes written.
+// Implements io.WriterTo.
+func (list GoListC[T]) WriteTo(w io.Writer) (int64, error) {
+    return listtext.Write(textFormat, w, list.All())
+}
+
+// Implements fmt.Formatter. Verbs %v and %s format the list same as String
+// method, %+v also prints the index of each node, such as [0:"a"=>1:"b"=>], and
+// %#v prints the list in Go syntax, such as golistc.New(1, 2, 3). Precision
+// limits the number of printed nodes, so %.10v prints only the first 10 nodes
+// followed by an ellipsis and the total number of nodes. Other verbs, flags
+// and width are applied to each node data.
+func (list GoListC[T]) Format(state fmt.State, verb rune) {
+    if verb == 's' {
+        verb = 'v'
+    }
+    limit, truncate := state.Precision()
+    goSyntax := verb == 'v' && state.Flag('#')
+    indexed := verb == 'v' && state.Flag('+')
+    format, quoted := dataFormat(state, verb), dataFormat(state, 'q')
+
+    if goSyntax && list.Head == nil {
+        fmt.Fprintf(state, "golistc.New[%v]()", reflect.TypeFor[T]())
+        return
+    } else if goSyntax {
+        io.WriteString(state, "golistc.New(")
+    } else {
+        io.WriteString(state, "[")
+    }
+    index := 0
+    for node := list.Head; node != nil; node = list.next(node) {
+        if truncate && index == limit {
+            if goSyntax {
+                fmt.Fprintf(state, "... /* %d total */", Len(list))
+            } else {
+                fmt.Fprintf(state, "...(%d total)", Len(list))
+            }
+            break
+        }
+        if indexed {
+            fmt.Fprintf(state, "%d:", index)
+        }
+        var data any = node.Data
+        if _, ok := data.(string); ok && verb == 'v' && !goSyntax {
+            fmt.Fprintf(state, quoted, data)
+        } else {
+            fmt.Fprintf(state, format, data)
+        }
+        if goSyntax && list.next(node) != nil {
+            io.WriteString(state, ", ")
+        } else if !goSyntax {
+            io.WriteString(state, "=>")
+        }
+        index++
+    }
+    if goSyntax {
+        io.WriteString(state, ")")
+    } else {
+        io.WriteString(state, "]")
+    }
+}
+
+/*
+ *******************************************************************************
+ * Internal functions and methods
+ *******************************************************************************
+ */
+
+// Returns a set of all nodes data of list.
+func toSet[T comparable](list GoListC[T]) map[T]struct{} {
+    set := make(map[T]struct{})
+    for node := list.Head; node != nil; node = list.next(node) {
+        set[node.Data] = struct{}{}
+    }
+    return set
+}
+
+// Do append value into head of list.
+func (list *GoListC[T]) append(value T) *GoListC[T] {
+    node := &node.Node[T]{Data: value}
+    if list.Head != nil {
+        node.Next = list.Head
+        list.Tail.Next = node
+        list.Tail = node
+    } else {
+        list.Head = node
+        list.Tail = node
+        node.Next = node
+    }
+    return list
+}
+
+// Do reverse the list.
+func (list *GoListC[T]) reverse() *GoListC[T] {
+    if list.Head == nil {
+        return list
+    }
+    prev := list.Tail
+    node := list.Head
+    for {
+        next := node.Next
+        node.Next = prev
+        prev = node
+        node = next
+        if node == list.Head {
+            break
+        }
+    }
+    list.Head = prev
+    list.Tail = node
+    return list
+}
+
+// Do append value into head of list.
+func (list *GoListC[T]) appendHead(value T) *GoListC[T] {
+    tail := list.Tail
+    list.append(value)
+    if tail != nil {
+        list.Head = list.Tail
+        list.Tail = tail
+    }
+    return list
+}
+
+// Returns the node following node in list, or nil when node is the Tail of
+// list. This lets loops walk the circular list once, same as a singly list.
+func (list GoListC[T]) next(node *node.Node[T]) *node.Node[T] {
+    if node == list.Tail {
+        return nil
+    }
+    return node.Next
+}
+
+// Returns index wrapped into range [0, len). len must be positive.
+func wrap(index, len int) int {
+    index %= len
+    if index < 0 {
+        index += len
+    }
+    return index
+}
+
+// Returns true if nodes data of every input list are in ascending order as
+// determined by cmp.
+func allSortedFunc[T any](lists []GoListC[T], cmp func(a, b T) int) bool {
+    for _, list := range lists {
+        for node := list.Head; node != nil && node != list.Tail; node = node.Next {
+            if cmp(node.Next.Data, node.Data) < 0 {
+                return false
+            }
+        }
+    }
+    return true
+}
+
+// Do k-way merge sorted input lists, using a heap of the current node of each
+// list. If unique is true, nodes that compare equal to the previous merged
+// node are skipped.
+func mergeFunc[T any](lists []GoListC[T], cmp func(a, b T) int, unique bool) GoListC[T] {
+    h := &mergeHeap[T]{cmp: cmp}
+    for i, list := range lists {
+        if list.Head != nil {
+            h.items = append(h.items, mergeItem[T]{node: list.Head, list: i})
+        }
+    }
+    heap.Init(h)
+
+    var result GoListC[T]
+    for h.Len() > 0 {
+        item := &h.items[0]
+        if !unique || result.Head == nil || cmp(item.node.Data, result.Tail.Data) != 0 {
+            result.append(item.node.Data)
+        }
+        if item.node = lists[item.list].next(item.node); item.node != nil {
+            heap.Fix(h, 0)
+        } else {
+            heap.Pop(h)
+        }
+    }
+    return result
+}
+
+// Current node of an input list of mergeFunc, and position of that list.
+type mergeItem[T any] struct {
+    node *node.Node[T]
+    list int
+}
+
+// Min-heap of mergeItem, implementing heap.Interface. Items are ordered by
+// node data, then by list position so that merging is stable.
+type mergeHeap[T any] struct {
+    items []mergeItem[T]
+    cmp   func(a, b T) int
+}
+
+func (h *mergeHeap[T]) Len() int {
+    return len(h.items)
+}
+
+func (h *mergeHeap[T]) Less(i, j int) bool {
+    c := h.cmp(h.items[i].node.Data, h.items[j].node.Data)
+    return c < 0 || c == 0 && h.items[i].list < h.items[j].list
+}
+
+func (h *mergeHeap[T]) Swap(i, j int) {
+    h.items[i], h.items[j] = h.items[j], h.items[i]
+}
+
+func (h *mergeHeap[T]) Push(x any) {
+    h.items = append(h.items, x.(mergeItem[T]))
+}
+
+func (h *mergeHeap[T]) Pop() any {
+    last := h.items[len(h.items)-1]
+    h.items = h.items[:len(h.items)-1]
+    return last
+}
+
+// Returns -1, 0 or 1 if a is less than, equal to or greater than b.
+func compare[T constraints.Ordered](a, b T) int {
+    switch {
+    case a < b:
+        return -1
+    case a > b:
+        return 1
+    }
+    return 0
+}
+
+// Do stable bottom-up merge sort the nodes of list in place, using cmp to
+// compare node data. The ring is opened while runs of width 1, 2, 4, ... are
+// merged pairwise, then closed again once one run covers the whole list.
+func (list *GoListC[T]) mergeSort(cmp func(a, b T) int) *GoListC[T] {
+    size := Len(*list)
+    if size < 2 {
+        return list
+    }
+
+    list.Tail.Next = nil
+    for width := 1; width < size; width *= 2 {
+        var dummy node.Node[T]
+        tail := &dummy
+        for curr := list.Head; curr != nil; {
+            left := curr
+            right := cutNodes(left, width)
+            curr = cutNodes(right, width)
+            tail = mergeNodes(tail, left, right, cmp)
+        }
+        list.Head = dummy.Next
+        list.Tail = tail
+    }
+    list.Tail.Next = list.Head
     return list
 }
+
+// Cuts the chain of nodes starting at head after n nodes, and returns the
+// first node of the remaining chain.
+func cutNodes[T any](head *node.Node[T], n int) *node.Node[T] {
+    for i := 1; head != nil && i < n; i++ {
+        head = head.Next
+    }
+    if head == nil {
+        return nil
+    }
+    rest := head.Next
+    head.Next = nil
+    return rest
+}
+
+// Links the nodes of sorted chains left and right after tail in sorted order,
+// and returns the last linked node. Nodes of left come first when equal.
+func mergeNodes[T any](tail, left, right *node.Node[T], cmp func(a, b T) int) *node.Node[T] {
+    for left != nil && right != nil {
+        if cmp(right.Data, left.Data) < 0 {
+            tail.Next = right
+            right = right.Next
+        } else {
+            tail.Next = left
+            left = left.Next
+        }
+        tail = tail.Next
+    }
+    if left != nil {
+        tail.Next = left
+    } else {
+        tail.Next = right
+    }
+    for tail.Next != nil {
+        tail = tail.Next
+    }
+    return tail
+}
+
+// Returns the format applying verb, flags and width of state to node data.
+// Flag + is dropped for %q, which would escape non-ASCII characters, so
+// strings are quoted same as String method.
+func dataFormat(state fmt.State, verb rune) string {
+    format := "%"
+    for _, flag := range "+-# 0" {
+        if state.Flag(int(flag)) && !(flag == '+' && verb == 'q') {
+            format += string(flag)
+        }
+    }
+    if width, ok := state.Width(); ok {
+        format += strconv.Itoa(width)
+    }
+    return format + string(verb)
+}
+
+// Returns the node where the cycle reached from head starts, or nil if Next
+// pointers from head end with nil.
+func cycleStart[T any](head *node.Node[T]) *node.Node[T] {
+    slow, fast := head, head
+    for fast != nil && fast.Next != nil {
+        slow, fast = slow.Next, fast.Next.Next
+        if slow == fast {
+            for slow = head; slow != fast; slow, fast = slow.Next, fast.Next {
+            }
+            return slow
+        }
+    }
+    return nil
+}
+
+// Returns index of target in the nodes reached from head.
+func nodePosition[T any](head, target *node.Node[T]) int {
+    index := 0
+    for node := head; node != target; node = node.Next {
+        index++
+    }
+    return index
+}
@@ -2,7 +2,17 @@ package golistc
 
 import (
     "testing"
+    "bytes"
+    "encoding/gob"
+    "encoding/json"
+    "errors"
     "reflect"
+    "strings"
+    "fmt"
+    "slices"
+    "strconv"
+    "maps"
+    "math"
 )
 
 func TestNew_ToSlice(t *testing.T) {
@@ -40,10 +50,10 @@ func TestGoListString_String(t *testing.T) {
 func TestAll(t *testing.T) {
     list1 := New(1, 3, 5, 7)
     list2 := New(1, 3, 5, 8)
-    if result1 := All(list1, func(n int) bool { return n%2 != 0 }); false {
+    if result1 := All(list1, func(n int) bool { return n%2 != 0 }); !result1 {
         t.Errorf("All\nresult1: %v\nexpected: true", result1)
     }
-    if result2 := All(list2, func(n int) bool { return n%2 != 0 }); false {
+    if result2 := All(list2, func(n int) bool { return n%2 != 0 }); result2 {
         t.Errorf("All\nresult2: %v\nexpected: false", result2)
     }
 }
@@ -51,10 +61,10 @@ func TestAll(t *testing.T) {
 func TestAny(t *testing.T) {
     list1 := New(2, 4, 6, 7)
     list2 := New(2, 4, 6, 8)
-    if result1 := Any(list1, func(n int) bool { return n%2 != 0}); false {
+    if result1 := Any(list1, func(n int) bool { return n%2 != 0}); !result1 {
         t.Errorf("Any\nresult1: %v\nexpected: true", result1)
     }
-    if result2 := Any(list2, func(n int) bool { return n%2 != 0}); false {
+    if result2 := Any(list2, func(n int) bool { return n%2 != 0}); result2 {
         t.Errorf("Any\nresult2: %v\nexpected: false", result2)
     }
 }
@@ -75,4 +85,1391 @@ func TestAppendHead(t *testing.T) {
     if result := ToSlice(appended); !reflect.DeepEqual(result, expected) {
         t.Errorf("AppendHead\nresult: %v\nexpected: %v", result, expected)
     }
-}
\ No newline at end of file
+}
+
+func TestConcat(t *testing.T) {
+    list1 := New(1, 2, 3)
+    list2 := New(4, 5, 6)
+    list3 := New(7, 8, 9)
+    concatenated := Concat(list1, list2, list3)
+    expected := []int{1, 2, 3, 4, 5, 6, 7, 8, 9}
+    if result := ToSlice(concatenated); !reflect.DeepEqual(result, expected) {
+        t.Errorf("Concat\nresult: %v\nexpected: %v", result, expected)
+    }
+}
+
+func TestDelete_NormalCase(t *testing.T) {
+    list := New(1, 2, 3, 2, 4)
+    deleted := Delete(list, 2)
+    expected := []int{1, 3, 2, 4}
+    if result := ToSlice(deleted); !reflect.DeepEqual(result, expected) {
+        t.Errorf("Delete\nresult: %v\nexpected: %v", result, expected)
+    }
+}
+
+func TestDelete_EmptyList(t *testing.T) {
+    list := New[int]()
+    deleted := Delete(list, 0)
+    if result := Len(deleted); result != 0 {
+        t.Errorf("Delete\nresult: %v\nexpected: []", result)
+    }
+}
+
+func TestDeleteAt_NormalCase(t *testing.T) {
+    list := New("a", "b", "c", "d")
+    deleted := DeleteAt(list, -2)
+    expected := []string{"a", "b", "d"}
+    if result := ToSlice(deleted); !reflect.DeepEqual(result, expected) {
+        t.Errorf("DeleteAt\nresult: %v\nexpected: %v", result, expected)
+    }
+}
+
+func TestDeleteAt_EmptyList(t *testing.T) {
+    list := New[int]()
+    deleted := DeleteAt(list, 0)
+    if result := Len(deleted); result != 0 {
+        t.Errorf("Delete\nresult: %v\nexpected: []", result)
+    }
+}
+
+func TestDropLast(t *testing.T) {
+    list := New("a", "b", "c", "d")
+    droped := DropLast(list)
+    expected := []string{"a", "b", "c"}
+    if result := ToSlice(droped); !reflect.DeepEqual(result, expected) {
+        t.Errorf("DropLast\nresult: %v\nexpected: %v", result, expected)
+    }
+}
+
+func TestDropWhile_TakeWhile(t *testing.T) {
+    list := New(1, 2, 3, 4, 5, 2)
+    droped := DropWhile(list, func(n int) bool { return n < 4 })
+    taken := TakeWhile(list, func(n int) bool { return n < 4 })
+    expected1 := []int{4, 5, 2}
+    expected2 := []int{1, 2, 3}
+    if result := ToSlice(droped); !reflect.DeepEqual(result, expected1) {
+        t.Errorf("DropWhile\nresult: %v\nexpected: %v", result, expected1)
+    }
+    if result := ToSlice(taken); !reflect.DeepEqual(result, expected2) {
+        t.Errorf("TakeWhile\nresult: %v\nexpected: %v", result, expected2)
+    }
+}
+
+func TestDuplicate(t *testing.T) {
+    duplicate := Duplicate(4, 0)
+    expected := []int{0, 0, 0, 0}
+    if result := ToSlice(duplicate); !reflect.DeepEqual(result, expected) {
+        t.Errorf("Duplicate\nresult: %v\nexpected: %v", result, expected)
+    }
+}
+
+func TestEqual_ReturnTrue(t *testing.T) {
+    list1 := New(1, 2, 3, 4)
+    list2 := New(1, 2, 3, 4)
+    if !Equal(list1, list2) {
+        t.Errorf("Equal\nExpected true but got false")
+    }
+}
+
+func TestEqual_SameLenReturnFalse(t *testing.T) {
+    list1 := New(1, 2, 3, 4)
+    list2 := New(1, 2, 4, 3)
+    if Equal(list1, list2) {
+        t.Errorf("Equal\nExpected false but got true")
+    }
+}
+
+func TestEqual_List1Longer(t *testing.T) {
+    list1 := New(1, 2, 3, 4, 5)
+    list2 := New(1, 2, 3, 4)
+    if Equal(list1, list2) {
+        t.Errorf("Equal\nExpected false but got true")
+    }
+}
+
+func TestEqual_List2Longer(t *testing.T) {
+    list1 := New(1, 2, 3, 4)
+    list2 := New(1, 2, 3, 4, 5)
+    if Equal(list1, list2) {
+        t.Errorf("Equal\nExpected false but got true")
+    }
+}
+
+func TestFilter_NormalCase(t *testing.T) {
+    list := New(1, 2, 3, 4, 5, 6)
+    filtered := Filter(list, func(n int) bool { return n%2 == 0 })
+    expected := []int{2, 4, 6}
+    if result := ToSlice(filtered); !reflect.DeepEqual(result, expected) {
+        t.Errorf("Filter\nresult: %v\nexpected: %v", result, expected)
+    }
+}
+
+func TestFilter_EmptyList(t *testing.T) {
+    list := New[int]()
+    filtered := Filter(list, func(n int) bool { return n%2 != 0 })
+    if result := Len(filtered); result != 0 {
+        t.Errorf("Filter\nresult: %v\nexpected: []", result)
+    }
+}
+
+func TestFilterMap_NormalCase(t *testing.T) {
+    list := New(1, 2, 3, 4, 5, 6)
+    filtered := FilterMap(list, func(n int) (bool, int) {
+        return n % 2 != 0, n * n
+    })
+    expected := []int{1, 9, 25}
+    if result := ToSlice(filtered); !reflect.DeepEqual(result, expected) {
+        t.Errorf("FilterMap\nresult: %v\nexpected: %v", result, expected)
+    }
+}
+
+func TestFilterMap_EmptyList(t *testing.T) {
+    list := New[int]()
+    filtered := FilterMap(list, func(n int) (bool, int) {
+        return n % 2 == 0, n * 2
+    })
+    if result := Len(filtered); result != 0 {
+        t.Errorf("FilterMap\nresult: %v\nexpected: []", result)
+    }
+}
+
+func TestFind_Found(t *testing.T) {
+    list := New(1, 2, 3, 4)
+    result := Find(list, 3)
+    expected := 2
+    if result != expected {
+        t.Errorf("Find\nresult: %v\nexpected: %v", result, expected)
+    }
+}
+
+func TestFind_NotFound(t *testing.T) {
+    list := New(1, 2, 3, 4)
+    result := Find(list, 5)
+    expected := -1
+    if result != expected {
+        t.Errorf("Find\nresult: %v\nexpected: %v", result, expected)
+    }
+}
+
+func TestFoldl(t *testing.T) {
+    list := New(1, 2, 3, 4, 5)
+    result := Foldl(list, 0, func(n, s int) int { return n + s })
+    expected := 15
+    if result != expected {
+        t.Errorf("Find\nresult: %v\nexpected: %v", result, expected)
+    }
+}
+
+func TestFoldr(t *testing.T) {
+    list := New(1, 2, 3, 4, 5)
+    result := Foldr(list, 1, func(n, s int) int { return n * s })
+    expected := 120
+    if result != expected {
+        t.Errorf("Find\nresult: %v\nexpected: %v", result, expected)
+    }
+}
+
+func TestForEach(t *testing.T) {
+    list := New(1, 2, 3, 4, 5)
+
+    var result []int
+    ForEach(list, func(val int) {
+        result = append(result, val)
+    })
+
+    expected := []int{1, 2, 3, 4, 5}
+    if !reflect.DeepEqual(result, expected) {
+        t.Errorf("ForEach\nresult: %v\nexpected: %v", result, expected)
+    }
+}
+
+func TestInsertAt_NormalCase(t *testing.T) {
+    list := New("a", "b", "c", "d")
+
+    inserted1 := InsertAt(list, -2, "X")
+    expected1 := []string{"a", "b", "X", "c", "d"}
+    if result1 := ToSlice(inserted1); !reflect.DeepEqual(result1, expected1) {
+        t.Errorf("InsertAt\nresult: %v\nexpected: %v", result1, expected1)
+    }
+
+    inserted2 := InsertAt(list, 4, "X")
+    expected2 := []string{"a", "b", "c", "d", "X"}
+    if result2 := ToSlice(inserted2); !reflect.DeepEqual(result2, expected2) {
+        t.Errorf("InsertAt\nresult: %v\nexpected: %v", result2, expected2)
+    }
+}
+
+func TestInsertAt_IndexOutOfBound(t *testing.T) {
+    defer func() {
+        if r := recover(); r == nil {
+            t.Errorf("InsertAt\nExpect panic")
+        } else if r != "InsertAt, index is out of bound!" {
+            t.Errorf("InsertAt\nWrong panic message")
+        }
+    }()
+    InsertAt(New(1, 2, 3, 4), 10, 0)
+}
+
+func TestJoin(t *testing.T) {
+    list := New("a", "b", "c", "d")
+    joined := Join(list, "X")
+    expected := []string{"a", "X", "b", "X", "c", "X", "d"}
+    if result := ToSlice(joined); !reflect.DeepEqual(result, expected) {
+        t.Errorf("Join\nresult: %v\nexpected: %v", result, expected)
+    }
+}
+
+func TestLast(t *testing.T) {
+    last1 := Last(New(1, 2, 3, 4))
+    expected1 := 4
+    if last1.Data != expected1 {
+        t.Errorf("Last\nresult: %v\nexpected: %v", last1, expected1)
+    }
+
+    last2 := Last(New[int]())
+    if last2 != nil {
+        t.Errorf("Last\nresult: %v\nexpected: nil", last2)
+    }
+}
+
+func TestMap(t *testing.T) {
+    list := New(1, 2, 3, 4)
+    mapped := Map(list, func(n int) int { return n * n })
+    expected := []int{1, 4, 9, 16}
+    if result := ToSlice(mapped); !reflect.DeepEqual(result, expected) {
+        t.Errorf("Map\nresult: %v\nexpected: %v", result, expected)
+    }
+}
+
+func TestMapFoldl_MapFoldr(t *testing.T) {
+    list := New(1, 2, 3, 4)
+    mapped1, sum := MapFoldl(list, 0, func(n, s int) (int, int) {
+        return n * 2, s + n
+    })
+    mapped2, fac := MapFoldr(list, 1, func(n, f int) (int, int) {
+        return n * 2, f * n
+    })
+    expectedL := []int{2, 4, 6, 8}
+    expectedS := 10
+    expectedF := 24
+    if result1 := ToSlice(mapped1); !reflect.DeepEqual(result1, expectedL) || sum != expectedS {
+        t.Errorf("MapFoldl\nresult: %v - %v\nexpected: %v - %v", sum, result1, expectedS, expectedL)
+    }
+    if result2 := ToSlice(mapped2); !reflect.DeepEqual(result2, expectedL) || fac != expectedF {
+        t.Errorf("MapFoldr\nresult: %v - %v\nexpected: %v - %v", fac, result2, expectedF, expectedL)
+    }
+}
+
+func TestMax_Min(t *testing.T) {
+    list := New("d", "b", "e", "a", "c")
+    max := Max(list)
+    min := Min(list)
+    expectedMax := "e"
+    expectedMin := "a"
+
+    if max.Data != expectedMax {
+        t.Errorf("Max\nresult: %v\nexpected: %v", max, expectedMax)
+    }
+    if min.Data != expectedMin {
+        t.Errorf("Min\nresult: %v\nexpected: %v", min, expectedMin)
+    }
+}
+
+func TestMember(t *testing.T) {
+    list := New(1, 2, 3, 4, 5)
+    member1 := Member(list, 4)
+    member2 := Member(list, 6)
+    if !member1 {
+        t.Errorf("Member\nresult: %v\nexpected: true", member1)
+    }
+    if member2 {
+        t.Errorf("Member\nresult: %v\nexpected: false", member2)
+    }
+}
+
+func TestMerge_UMerge(t *testing.T) {
+    list1 := New(2, 8, 6)
+    list2 := New(1, 3, 3)
+    list3 := New(8, 4, 5)
+    merged := Merge(list1, list2, list3)
+    umerged := UMerge(list1, list2, list3)
+
+    expected1 := []int{1, 2, 3, 3, 4, 5, 6, 8, 8}
+    expected2 := []int{1, 2, 3, 4, 5, 6, 8}
+
+    if result1 := ToSlice(merged); !reflect.DeepEqual(result1, expected1) {
+        t.Errorf("Merge\nresult: %v\nexpected: %v", result1, expected1)
+    }
+    if result2 := ToSlice(umerged); !reflect.DeepEqual(result2, expected2) {
+        t.Errorf("UMerge\nresult: %v\nexpected: %v", result2, expected2)
+    }
+}
+
+func TestNth_NormalCase(t *testing.T) {
+    list := New(1, 2, 3, 4, 5)
+    nth1 := Nth(list, 2)
+    nth2 := Nth(list, -2)
+
+    expected1 := 3
+    expected2 := 4
+
+    if nth1.Data != expected1 {
+        t.Errorf("Nth\nresult: %v\nexpected: %v", nth1, expected1)
+    }
+    if nth2.Data != expected2 {
+        t.Errorf("Nth\nresult: %v\nexpected: %v", nth2, expected2)
+    }
+}
+
+func TestNthTail_NormalCase(t *testing.T) {
+    list := New("a", "b", "c", "d", "e")
+    tail := NthTail(list, -3)
+    expected := []string{"c", "d", "e"}
+
+    if result := ToSlice(tail); !reflect.DeepEqual(result, expected) {
+        t.Errorf("NthTail\nresult: %v\nexpected: %v", result, expected)
+    }
+}
+
+func TestPartition(t *testing.T) {
+    input := New(1, 2, 3, 4, 5, 6)
+    list1, list2 := Partition(input, func(n int) bool { return n % 2 != 0 })
+    expected1 := []int{1, 3, 5}
+    expected2 := []int{2, 4, 6}
+
+    if result1 := ToSlice(list1); !reflect.DeepEqual(result1, expected1) {
+        t.Errorf("Partition\nresult: %v\nexpected: %v", result1, expected1)
+    }
+    if result2 := ToSlice(list2); !reflect.DeepEqual(result2, expected2) {
+        t.Errorf("Partition\nresult: %v\nexpected: %v", result2, expected2)
+    }
+}
+
+func TestPreffix_Suffix(t *testing.T) {
+    list1 := New("a", "b")
+    list2 := New("e", "f")
+    list3 := New("a", "b", "c", "d", "e", "f")
+
+    if result := Prefix(list1, list3); !result {
+        t.Errorf("Prefix\nresult: %v\nexpected: true", result)
+    }
+    if result := Prefix(list2, list3); result {
+        t.Errorf("Prefix\nresult: %v\nexpected: false", result)
+    }
+
+    if result := Suffix(list2, list3); !result {
+        t.Errorf("Suffix\nresult: %v\nexpected: true", result)
+    }
+    if result := Suffix(list1, list3); result {
+        t.Errorf("Suffix\nresult: %v\nexpected: false", result)
+    }
+}
+
+func TestReplaceAt_UpdateAt(t *testing.T) {
+    list := New(1, 2, 3, 4)
+    replaced := ReplaceAt(list, -2, 0)
+    updated := UpdateAt(list, -3, func(n int) int { return n * n })
+
+    expected1 := []int{1, 2, 0, 4}
+    expected2 := []int{1, 4, 3, 4}
+
+    if result := ToSlice(replaced); !reflect.DeepEqual(result, expected1) {
+        t.Errorf("ReplaceAt\nresult: %v\nexpected: %v", result, expected1)
+    }
+    if result := ToSlice(updated); !reflect.DeepEqual(result, expected2) {
+        t.Errorf("UpdateAt\nresult: %v\nexpected: %v", result, expected2)
+    }
+}
+
+func TestSearch(t *testing.T) {
+    list := New(1, 2, 3, 4)
+    index1, node1 := Search(list, func(n int) bool { return n % 2 == 0 })
+    index2, _ := Search(list, func(n int) bool { return n > 4 })
+
+    if index1 != 1 || node1.Data != 2 {
+        t.Errorf("Search\nresult: %v - %v\nexpected: 1 - 2", index1, node1)
+    }
+    if index2 != -1 {
+        t.Errorf("Search\nresult: %v\nexpected: -1", index2)
+    }
+}
+
+func TestSeq(t *testing.T) {
+    list := Seq(1, 10, 2)
+    expected := []int{1, 3, 5, 7, 9}
+    if result := ToSlice(list); !reflect.DeepEqual(result, expected) {
+        t.Errorf("Seq\nresult: %v\nexpected: %v", result, expected)
+    }
+}
+
+func TestSplit_NormalCase(t *testing.T) {
+    list1, list2 := Split(New("a", "b", "c", "d", "e"), -3)
+    expected1 := []string{"a", "b"}
+    expected2 := []string{"c", "d", "e"}
+
+    if result := ToSlice(list1); !reflect.DeepEqual(result, expected1) {
+        t.Errorf("Split\nresult: %v\nexpected: %v", result, expected1)
+    }
+    if result := ToSlice(list2); !reflect.DeepEqual(result, expected2) {
+        t.Errorf("Split\nresult: %v\nexpected: %v", result, expected2)
+    }
+}
+
+func TestSplit_IndexOutOfBound(t *testing.T) {
+    defer func() {
+        if r := recover(); r == nil {
+            t.Errorf("Split\nExpect panic")
+        } else if r != "Split, n is out of bound!" {
+            t.Errorf("Split\nWrong panic message")
+        }
+    }()
+    Split(New(1, 2, 3, 4, 5), 6)
+}
+
+func TestSplitWith(t *testing.T) {
+    list := New(1, 2, 3, 4, 1, 3)
+    list1, list2 := SplitWith(list, func(n int) bool { return n < 4 })
+    expected1 := []int{1, 2, 3}
+    expected2 := []int{4, 1, 3}
+    if result := ToSlice(list1); !reflect.DeepEqual(result, expected1) {
+        t.Errorf("SplitWith\nresult: %v\nexpected: %v", result, expected1)
+    }
+    if result := ToSlice(list2); !reflect.DeepEqual(result, expected2) {
+        t.Errorf("SplitWith\nresult: %v\nexpected: %v", result, expected2)
+    }
+}
+
+func TestSublist_NormalCase(t *testing.T) {
+    list := New("a", "b", "c", "d", "e", "f")
+    sublist := Sublist(list, 2, 3)
+    expected := []string{"c", "d", "e"}
+    if result := ToSlice(sublist); !reflect.DeepEqual(result, expected) {
+        t.Errorf("Sublist\nresult: %v\nexpected: %v", result, expected)
+    }
+}
+
+func TestSublist_NegativeLen(t *testing.T) {
+    defer func() {
+        if r := recover(); r == nil {
+            t.Errorf("Sublist\nExpect panic")
+        } else if r != "Sublist, input len must not be negative!" {
+            t.Errorf("Sublist\nWrong panic message")
+        }
+    }()
+    Sublist(New(1, 2, 3, 4), 2, -2)
+}
+
+func TestSubtract_NormalCase(t *testing.T) {
+    list1 := New("a","b","c","b","a","b")
+    list2 := New("b","a","b")
+    subtract := Subtract(list1, list2)
+    expected := []string{"c", "a", "b"}
+    if result := ToSlice(subtract); !reflect.DeepEqual(result, expected) {
+        t.Errorf("Subtract\nresult: %v\nexpected: %v", result, expected)
+    }
+}
+
+func TestSubtract_EmptyList1(t *testing.T) {
+    list1 := New[int]()
+    list2 := New(1, 2, 3)
+    subtract := Subtract(list1, list2)
+    if result := Len(subtract); result != 0 {
+        t.Errorf("Subtract\nresult: %v\nexpected: []", result)
+    }
+}
+
+func TestSum(t *testing.T) {
+    list := New("a", "b", "c", "d", "e", "f")
+    sum := Sum(list)
+    expected := "abcdef"
+    if sum != expected {
+        t.Errorf("Sum\nresult: %v\nexpected: %v", sum, expected)
+    }
+}
+
+func TestUSort(t *testing.T) {
+    list := New(2, 5, 1, 2, 7, 3, 9, 4, 8, 6, 4)
+    sorted := USort(list)
+    expected := []int{1, 2, 3, 4, 5, 6, 7, 8, 9}
+    if result := ToSlice(sorted); !reflect.DeepEqual(result, expected) {
+        t.Errorf("USort\nresult: %v\nexpected: %v", result, expected)
+    }
+}
+
+func TestCircular_TailLinksHead(t *testing.T) {
+    list := Append(New(1, 2), 3)
+    if list.Tail.Data != 3 || list.Tail.Next != list.Head {
+        t.Errorf("Append\nTail: %v\nexpected Tail 3 linked to Head", list.Tail)
+    }
+}
+
+func TestNth_Wrap(t *testing.T) {
+    list := New(1, 2, 3, 4, 5)
+    nth1 := Nth(list, 7)
+    nth2 := Nth(list, -6)
+
+    expected1 := 3
+    expected2 := 5
+
+    if nth1.Data != expected1 {
+        t.Errorf("Nth\nresult: %v\nexpected: %v", nth1, expected1)
+    }
+    if nth2.Data != expected2 {
+        t.Errorf("Nth\nresult: %v\nexpected: %v", nth2, expected2)
+    }
+}
+
+func TestNth_EmptyList(t *testing.T) {
+    defer func() {
+        if r := recover(); r == nil {
+            t.Errorf("Nth\nExpect panic")
+        } else if r != "Nth, list is empty!" {
+            t.Errorf("Nth\nWrong panic message")
+        }
+    }()
+    Nth(New[int](), 0)
+}
+
+func TestNthTail_Wrap(t *testing.T) {
+    list := New("a", "b", "c", "d", "e")
+    tail := NthTail(list, 8)
+    expected := []string{"d", "e"}
+
+    if result := ToSlice(tail); !reflect.DeepEqual(result, expected) {
+        t.Errorf("NthTail\nresult: %v\nexpected: %v", result, expected)
+    }
+}
+
+func TestDeleteAt_Wrap(t *testing.T) {
+    list := New("a", "b", "c", "d")
+    deleted := DeleteAt(list, 4)
+    expected := []string{"b", "c", "d"}
+    if result := ToSlice(deleted); !reflect.DeepEqual(result, expected) {
+        t.Errorf("DeleteAt\nresult: %v\nexpected: %v", result, expected)
+    }
+}
+
+func TestSublist_Wrap(t *testing.T) {
+    list := New("a", "b", "c", "d", "e", "f")
+
+    sublist1 := Sublist(list, -2, 4)
+    expected1 := []string{"e", "f", "a", "b"}
+    if result := ToSlice(sublist1); !reflect.DeepEqual(result, expected1) {
+        t.Errorf("Sublist\nresult: %v\nexpected: %v", result, expected1)
+    }
+
+    sublist2 := Sublist(list, 3, 10)
+    expected2 := []string{"d", "e", "f", "a", "b", "c"}
+    if result := ToSlice(sublist2); !reflect.DeepEqual(result, expected2) {
+        t.Errorf("Sublist\nresult: %v\nexpected: %v", result, expected2)
+    }
+}
+
+func TestRotate(t *testing.T) {
+    list := New(1, 2, 3, 4, 5)
+
+    rotated1 := Rotate(list, 2)
+    expected1 := []int{3, 4, 5, 1, 2}
+    if result := ToSlice(rotated1); !reflect.DeepEqual(result, expected1) {
+        t.Errorf("Rotate\nresult: %v\nexpected: %v", result, expected1)
+    }
+
+    rotated2 := Rotate(list, -1)
+    expected2 := []int{5, 1, 2, 3, 4}
+    if result := ToSlice(rotated2); !reflect.DeepEqual(result, expected2) {
+        t.Errorf("Rotate\nresult: %v\nexpected: %v", result, expected2)
+    }
+}
+
+func TestReverse(t *testing.T) {
+    list := New(1, 2, 3, 4)
+    reversed := Reverse(list)
+    expected := []int{4, 3, 2, 1}
+    if result := ToSlice(reversed); !reflect.DeepEqual(result, expected) {
+        t.Errorf("Reverse\nresult: %v\nexpected: %v", result, expected)
+    }
+    if reversed.Head.Data != 4 || reversed.Tail.Data != 1 || reversed.Tail.Next != reversed.Head {
+        t.Errorf("Reverse\nHead: %v, Tail: %v\nexpected: Head 4, Tail 1 linked to Head", reversed.Head, reversed.Tail)
+    }
+}
+
+func TestZeroValue_Slices(t *testing.T) {
+    var list GoListC[int]
+    if result := ToSlice(list); len(result) != 0 {
+        t.Errorf("ToSlice\nresult: %v\nexpected: []", result)
+    }
+    if result := ToSlice(New[int]()); len(result) != 0 {
+        t.Errorf("New\nresult: %v\nexpected: []", result)
+    }
+    if result := ToSlice(FromSlice([]int{})); len(result) != 0 {
+        t.Errorf("FromSlice\nresult: %v\nexpected: []", result)
+    }
+    if result := list.String(); result != "[]" {
+        t.Errorf("String\nresult: %v\nexpected: []", result)
+    }
+}
+
+func TestZeroValue_Predicates(t *testing.T) {
+    var list GoListC[int]
+    isOdd := func(n int) bool { return n%2 != 0 }
+    if !All(list, isOdd) {
+        t.Errorf("All\nresult: false\nexpected: true")
+    }
+    if Any(list, isOdd) {
+        t.Errorf("Any\nresult: true\nexpected: false")
+    }
+    if Member(list, 0) {
+        t.Errorf("Member\nresult: true\nexpected: false")
+    }
+    if result := Find(list, 0); result != -1 {
+        t.Errorf("Find\nresult: %v\nexpected: -1", result)
+    }
+    if result, _ := Search(list, isOdd); result != -1 {
+        t.Errorf("Search\nresult: %v\nexpected: -1", result)
+    }
+    if !Equal(list, New[int]()) || Equal(list, New(1)) {
+        t.Errorf("Equal\nwrong result for empty list")
+    }
+    if !Prefix(list, New(1)) || Prefix(New(1), list) {
+        t.Errorf("Prefix\nwrong result for empty list")
+    }
+    if !Suffix(list, New(1)) || Suffix(New(1), list) {
+        t.Errorf("Suffix\nwrong result for empty list")
+    }
+}
+
+func TestZeroValue_Accumulators(t *testing.T) {
+    var list GoListC[int]
+    sum := func(n, s int) int { return n + s }
+    if result := Len(list); result != 0 {
+        t.Errorf("Len\nresult: %v\nexpected: 0", result)
+    }
+    if result := Sum(list); result != 0 {
+        t.Errorf("Sum\nresult: %v\nexpected: 0", result)
+    }
+    if result := Foldl(list, 7, sum); result != 7 {
+        t.Errorf("Foldl\nresult: %v\nexpected: 7", result)
+    }
+    if result := Foldr(list, 7, sum); result != 7 {
+        t.Errorf("Foldr\nresult: %v\nexpected: 7", result)
+    }
+    calls := 0
+    ForEach(list, func(int) { calls++ })
+    if calls != 0 {
+        t.Errorf("ForEach\ncalls: %v\nexpected: 0", calls)
+    }
+    if Last(list) != nil || Max(list) != nil || Min(list) != nil {
+        t.Errorf("Last, Max, Min\nexpected nil nodes for empty list")
+    }
+    mapped1, acc1 := MapFoldl(list, 7, func(n, s int) (int, int) { return n, s + n })
+    mapped2, acc2 := MapFoldr(list, 7, func(n, s int) (int, int) { return n, s + n })
+    if Len(mapped1) != 0 || acc1 != 7 || Len(mapped2) != 0 || acc2 != 7 {
+        t.Errorf("MapFoldl, MapFoldr\nexpected empty list and acc0")
+    }
+}
+
+func TestZeroValue_Lists(t *testing.T) {
+    var list GoListC[int]
+    isOdd := func(n int) bool { return n%2 != 0 }
+    square := func(n int) int { return n * n }
+    results := map[string]GoListC[int]{
+        "Append":     Append(list),
+        "AppendHead": AppendHead(list),
+        "Concat":     Concat(list, list),
+        "Delete":     Delete(list, 0),
+        "DeleteAt":   DeleteAt(list, 0),
+        "DropLast":   DropLast(list),
+        "DropWhile":  DropWhile(list, isOdd),
+        "Duplicate":  Duplicate(0, 0),
+        "Filter":     Filter(list, isOdd),
+        "FilterMap":  FilterMap(list, func(n int) (bool, int) { return true, n }),
+        "Join":       Join(list, 0),
+        "Map":        Map(list, square),
+        "Merge":      Merge(list, list),
+        "ReplaceAt":  ReplaceAt(list, 0, 1),
+        "Reverse":    Reverse(list),
+        "Rotate":     Rotate(list, 1),
+        "Seq":        Seq(1, 0, 1),
+        "Sort":       Sort(list),
+        "Subtract":   Subtract(list, New(1)),
+        "TakeWhile":  TakeWhile(list, isOdd),
+        "UMerge":     UMerge(list, list),
+        "USort":      USort(list),
+        "UpdateAt":   UpdateAt(list, 0, square),
+    }
+    list1, list2 := Partition(list, isOdd)
+    results["Partition1"], results["Partition2"] = list1, list2
+    list1, list2 = SplitWith(list, isOdd)
+    results["SplitWith1"], results["SplitWith2"] = list1, list2
+
+    for name, result := range results {
+        if result.Head != nil || result.Tail != nil {
+            t.Errorf("%v\nresult: %v\nexpected: []", name, result)
+        }
+    }
+}
+
+func TestZeroValue_Grow(t *testing.T) {
+    var list GoListC[int]
+    results := map[string]GoListC[int]{
+        "Append":     Append(list, 1),
+        "AppendHead": AppendHead(list, 1),
+        "InsertAt":   InsertAt(list, 0, 1),
+    }
+    for name, result := range results {
+        if result.Head == nil || result.Head != result.Tail || result.Tail.Next != result.Head {
+            t.Errorf("%v\nresult: %v\nexpected: circular [1=>]", name, result)
+        }
+    }
+}
+
+func TestZeroValue_Panics(t *testing.T) {
+    var list GoListC[int]
+    cases := map[string]func(){
+        "Nth":     func() { Nth(list, 0) },
+        "NthTail": func() { NthTail(list, 0) },
+        "Split":   func() { Split(list, 0) },
+        "Sublist": func() { Sublist(list, 0, 1) },
+    }
+    for name, fun := range cases {
+        func() {
+            defer func() {
+                if r := recover(); r == nil {
+                    t.Errorf("%v\nExpect panic", name)
+                }
+            }()
+            fun()
+        }()
+    }
+}
+
+func TestFromSeq_Collect(t *testing.T) {
+    list1 := FromSeq(slices.Values([]int{1, 2, 3, 4}))
+    list2 := Collect(maps.Keys(map[string]int{"a": 1}))
+    expected1 := []int{1, 2, 3, 4}
+    expected2 := []string{"a"}
+    if result := ToSlice(list1); !reflect.DeepEqual(result, expected1) {
+        t.Errorf("FromSeq\nresult: %v\nexpected: %v", result, expected1)
+    }
+    if result := ToSlice(list2); !reflect.DeepEqual(result, expected2) {
+        t.Errorf("Collect\nresult: %v\nexpected: %v", result, expected2)
+    }
+}
+
+func TestGoListCAll(t *testing.T) {
+    list := New(1, 2, 3, 4)
+    expected := []int{1, 2, 3, 4}
+    if result := slices.Collect(list.All()); !reflect.DeepEqual(result, expected) {
+        t.Errorf("All\nresult: %v\nexpected: %v", result, expected)
+    }
+
+    var result []int
+    for val := range list.All() {
+        if val == 3 {
+            break
+        }
+        result = append(result, val)
+    }
+    if expected := []int{1, 2}; !reflect.DeepEqual(result, expected) {
+        t.Errorf("All\nresult: %v\nexpected: %v", result, expected)
+    }
+}
+
+func TestGoListCCycle(t *testing.T) {
+    list := New("a", "b", "c")
+    var result []string
+    for val := range list.Cycle() {
+        if len(result) == 7 {
+            break
+        }
+        result = append(result, val)
+    }
+    expected := []string{"a", "b", "c", "a", "b", "c", "a"}
+    if !reflect.DeepEqual(result, expected) {
+        t.Errorf("Cycle\nresult: %v\nexpected: %v", result, expected)
+    }
+
+    for val := range New[string]().Cycle() {
+        t.Errorf("Cycle\nresult: %v\nexpected: no iteration", val)
+    }
+}
+
+func TestGoListCEnumerate(t *testing.T) {
+    list := New("a", "b", "c")
+    result := map[int]string{}
+    for i, val := range list.Enumerate() {
+        result[i] = val
+    }
+    expected := map[int]string{0: "a", 1: "b", 2: "c"}
+    if !reflect.DeepEqual(result, expected) {
+        t.Errorf("Enumerate\nresult: %v\nexpected: %v", result, expected)
+    }
+}
+
+func TestGoListCNodes(t *testing.T) {
+    list := New(1, 2, 3)
+    for node := range list.Nodes() {
+        node.Data *= 10
+    }
+    expected := []int{10, 20, 30}
+    if result := ToSlice(list); !reflect.DeepEqual(result, expected) {
+        t.Errorf("Nodes\nresult: %v\nexpected: %v", result, expected)
+    }
+}
+
+func TestMap_ChangeType(t *testing.T) {
+    list := New("a", "bb", "ccc")
+    mapped := Map(list, func(s string) int { return len(s) })
+    expected := []int{1, 2, 3}
+    if result := ToSlice(mapped); !reflect.DeepEqual(result, expected) {
+        t.Errorf("Map\nresult: %v\nexpected: %v", result, expected)
+    }
+}
+
+func TestFilterMap_ChangeType(t *testing.T) {
+    list := New(1, 2, 3, 4)
+    filtered := FilterMap(list, func(n int) (bool, string) {
+        return n%2 == 0, strconv.Itoa(n * n)
+    })
+    expected := []string{"4", "16"}
+    if result := ToSlice(filtered); !reflect.DeepEqual(result, expected) {
+        t.Errorf("FilterMap\nresult: %v\nexpected: %v", result, expected)
+    }
+}
+
+func TestMapFoldl_MapFoldr_ChangeType(t *testing.T) {
+    list := New(1, 2, 3)
+    mapped1, sum := MapFoldl(list, 0, func(n, s int) (string, int) {
+        return strconv.Itoa(s + n), s + n
+    })
+    mapped2, fac := MapFoldr(list, 1, func(n, f int) (string, int) {
+        return strconv.Itoa(f * n), f * n
+    })
+    expected1 := []string{"1", "3", "6"}
+    expected2 := []string{"6", "6", "3"}
+    if result := ToSlice(mapped1); !reflect.DeepEqual(result, expected1) || sum != 6 {
+        t.Errorf("MapFoldl\nresult: %v - %v\nexpected: %v - %v", sum, result, 6, expected1)
+    }
+    if result := ToSlice(mapped2); !reflect.DeepEqual(result, expected2) || fac != 6 {
+        t.Errorf("MapFoldr\nresult: %v - %v\nexpected: %v - %v", fac, result, 6, expected2)
+    }
+}
+
+func TestFlatMap(t *testing.T) {
+    list := New(1, 2, 3)
+    flatMapped := FlatMap(list, func(n int) GoListC[string] {
+        return Duplicate(n, strconv.Itoa(n))
+    })
+    expected := []string{"1", "2", "2", "3", "3", "3"}
+    if result := ToSlice(flatMapped); !reflect.DeepEqual(result, expected) {
+        t.Errorf("FlatMap\nresult: %v\nexpected: %v", result, expected)
+    }
+}
+
+func TestTryNth(t *testing.T) {
+    list := New(1, 2, 3)
+    node, err := TryNth(list, -1)
+    if err != nil || node.Data != 3 {
+        t.Errorf("TryNth\nresult: %v - %v\nexpected: 3 - nil", node, err)
+    }
+    if _, err := TryNth(New[int](), 0); !errors.Is(err, ErrEmptyList) {
+        t.Errorf("TryNth\nresult: %v\nexpected: %v", err, ErrEmptyList)
+    }
+    if node, err := TryNth(list, 3); err != nil || node != list.Head {
+        t.Errorf("TryNth\nresult: %v - %v\nexpected: 1 - nil", node, err)
+    }
+}
+
+func TestTryAt_IndexOutOfRange(t *testing.T) {
+    list := New("a", "b", "c")
+    _, err1 := TryInsertAt(list, -4, "x")
+    _, _, err2 := TrySplit(list, 3)
+    for i, err := range []error{err1, err2} {
+        if !errors.Is(err, ErrIndexOutOfRange) {
+            t.Errorf("Try #%v\nresult: %v\nexpected: %v", i+1, err, ErrIndexOutOfRange)
+        }
+    }
+}
+
+func TestTryAt_EmptyList(t *testing.T) {
+    var list GoListC[string]
+    _, err1 := TryDeleteAt(list, 0)
+    _, err2 := TryNthTail(list, 0)
+    _, err3 := TryReplaceAt(list, 0, "x")
+    _, _, err4 := TrySplit(list, 0)
+    _, err5 := TrySublist(list, 0, 1)
+    _, err6 := TryUpdateAt(list, 0, strings.ToUpper)
+    for i, err := range []error{err1, err2, err3, err4, err5, err6} {
+        if !errors.Is(err, ErrEmptyList) {
+            t.Errorf("Try #%v\nresult: %v\nexpected: %v", i+1, err, ErrEmptyList)
+        }
+    }
+    if _, err := TrySublist(New("a"), 0, -1); !errors.Is(err, ErrNegativeLength) {
+        t.Errorf("TrySublist\nresult: %v\nexpected: %v", err, ErrNegativeLength)
+    }
+}
+
+func TestTryAt_NormalCase(t *testing.T) {
+    list := New("a", "b", "c")
+    deleted, err1 := TryDeleteAt(list, 1)
+    inserted, err2 := TryInsertAt(list, 3, "d")
+    tail, err3 := TryNthTail(list, 1)
+    replaced, err4 := TryReplaceAt(list, 0, "x")
+    list1, list2, err5 := TrySplit(list, 1)
+    sublist, err6 := TrySublist(list, -2, 5)
+    updated, err7 := TryUpdateAt(list, -1, strings.ToUpper)
+    for i, err := range []error{err1, err2, err3, err4, err5, err6, err7} {
+        if err != nil {
+            t.Errorf("Try #%v\nresult: %v\nexpected: nil", i+1, err)
+        }
+    }
+    results := [][]string{
+        ToSlice(deleted), ToSlice(inserted), ToSlice(tail), ToSlice(replaced),
+        ToSlice(list1), ToSlice(list2), ToSlice(sublist), ToSlice(updated),
+    }
+    expected := [][]string{
+        {"a", "c"}, {"a", "b", "c", "d"}, {"b", "c"}, {"x", "b", "c"},
+        {"a"}, {"b", "c"}, {"b", "c", "a"}, {"a", "b", "C"},
+    }
+    if !reflect.DeepEqual(results, expected) {
+        t.Errorf("Try\nresult: %v\nexpected: %v", results, expected)
+    }
+}
+
+type person struct {
+    name string
+    age  int
+}
+
+func byAge(a, b person) int {
+    return a.age - b.age
+}
+
+func TestSortFunc_SortStableFunc(t *testing.T) {
+    list := New(person{"a", 30}, person{"b", 20}, person{"c", 30}, person{"d", 10})
+    sorted1 := SortFunc(list, byAge)
+    sorted2 := SortStableFunc(list, byAge)
+    expected := []person{{"d", 10}, {"b", 20}, {"a", 30}, {"c", 30}}
+    if result := ToSlice(sorted1); !reflect.DeepEqual(result, expected) {
+        t.Errorf("SortFunc\nresult: %v\nexpected: %v", result, expected)
+    }
+    if result := ToSlice(sorted2); !reflect.DeepEqual(result, expected) {
+        t.Errorf("SortStableFunc\nresult: %v\nexpected: %v", result, expected)
+    }
+}
+
+func TestSort_Large(t *testing.T) {
+    const n = 100000
+    sorted := Seq(0, n-1, 1)
+    lists := map[string]GoListC[int]{
+        "Sort":   Sort(sorted),
+        "USort":  USort(Concat(sorted, sorted)),
+        "Merge":  Merge(Seq(0, n-1, 2), Seq(1, n-1, 2)),
+        "UMerge": UMerge(sorted, Seq(0, n-1, 3)),
+    }
+    for name, list := range lists {
+        if !Equal(list, sorted) {
+            t.Errorf("%v\nresult is not 0, 1, ..., %v", name, n-1)
+        }
+        if list.Tail.Next != list.Head {
+            t.Errorf("%v\nresult is not circular", name)
+        }
+    }
+}
+
+func TestSort_NaN(t *testing.T) {
+    sorted := Sort(New(3.0, math.NaN(), 1.0))
+    if result := Len(sorted); result != 3 {
+        t.Errorf("Sort\nresult: %v\nexpected: %v", sorted, "3 nodes")
+    }
+    single := New(1)
+    if result := Sort(single); result.Head == single.Head {
+        t.Errorf("Sort\nresult shares nodes with input list")
+    }
+    if result := USort(single); result.Head == single.Head {
+        t.Errorf("USort\nresult shares nodes with input list")
+    }
+}
+
+func TestSortStableFunc_Large(t *testing.T) {
+    const n = 100000
+    lists := map[string]GoListC[int]{
+        "sorted":  Seq(0, n-1, 1),
+        "reverse": Reverse(Seq(0, n-1, 1)),
+    }
+    for name, list := range lists {
+        sorted := SortStableFunc(list, func(a, b int) int { return a - b })
+        if result := ToSlice(sorted); !slices.IsSorted(result) || len(result) != n {
+            t.Errorf("SortStableFunc\n%v list is not sorted", name)
+        }
+        if sorted.Tail.Next != sorted.Head {
+            t.Errorf("SortStableFunc\n%v list is not circular", name)
+        }
+    }
+}
+
+func TestUSortFunc(t *testing.T) {
+    list := New(person{"a", 30}, person{"b", 20}, person{"c", 30}, person{"d", 20})
+    sorted := USortFunc(list, byAge)
+    expected := []person{{"b", 20}, {"a", 30}}
+    if result := ToSlice(sorted); !reflect.DeepEqual(result, expected) {
+        t.Errorf("USortFunc\nresult: %v\nexpected: %v", result, expected)
+    }
+}
+
+func TestMergeFunc(t *testing.T) {
+    list1 := New(person{"a", 10}, person{"b", 30})
+    list2 := New(person{"c", 20}, person{"d", 30})
+    merged := MergeFunc(byAge, list1, list2)
+    expected := []person{{"a", 10}, {"c", 20}, {"b", 30}, {"d", 30}}
+    if result := ToSlice(merged); !reflect.DeepEqual(result, expected) {
+        t.Errorf("MergeFunc\nresult: %v\nexpected: %v", result, expected)
+    }
+}
+
+func TestMaxFunc_MinFunc(t *testing.T) {
+    list := New(person{"a", 20}, person{"b", 30}, person{"c", 10}, person{"d", 30}, person{"e", 10})
+    max := MaxFunc(list, byAge)
+    min := MinFunc(list, byAge)
+    if max.Data.name != "b" {
+        t.Errorf("MaxFunc\nresult: %v\nexpected: {b 30}", max)
+    }
+    if min.Data.name != "c" {
+        t.Errorf("MinFunc\nresult: %v\nexpected: {c 10}", min)
+    }
+    if MaxFunc(New[person](), byAge) != nil || MinFunc(New[person](), byAge) != nil {
+        t.Errorf("MaxFunc, MinFunc\nexpected nil for empty list")
+    }
+}
+
+func TestDeleteFunc(t *testing.T) {
+    list := New([]int{1}, []int{2, 3}, []int{4, 5})
+    deleted := DeleteFunc(list, func(s []int) bool { return len(s) == 2 })
+    expected := [][]int{{1}, {4, 5}}
+    if result := ToSlice(deleted); !reflect.DeepEqual(result, expected) {
+        t.Errorf("DeleteFunc\nresult: %v\nexpected: %v", result, expected)
+    }
+}
+
+func TestEqualFunc(t *testing.T) {
+    list1 := New([]int{1, 2}, []int{3})
+    list2 := New("1,2", "3")
+    eq := func(s []int, str string) bool {
+        return fmt.Sprint(s) == "["+strings.ReplaceAll(str, ",", " ")+"]"
+    }
+    if !EqualFunc(list1, list2, eq) {
+        t.Errorf("EqualFunc\nExpected true but got false")
+    }
+    if EqualFunc(list1, New("1,2"), eq) {
+        t.Errorf("EqualFunc\nExpected false but got true")
+    }
+}
+
+func TestIndexFunc(t *testing.T) {
+    list := New(1.5, 2.5, 3.5)
+    if result := IndexFunc(list, func(f float64) bool { return f > 2 }); result != 1 {
+        t.Errorf("IndexFunc\nresult: %v\nexpected: 1", result)
+    }
+    if result := IndexFunc(list, func(f float64) bool { return f > 4 }); result != -1 {
+        t.Errorf("IndexFunc\nresult: %v\nexpected: -1", result)
+    }
+}
+
+func TestSubtractFunc(t *testing.T) {
+    list1 := New("a", "B", "c", "b")
+    list2 := New("b", "C")
+    subtract := SubtractFunc(list1, list2, strings.EqualFold)
+    expected := []string{"a", "b"}
+    if result := ToSlice(subtract); !reflect.DeepEqual(result, expected) {
+        t.Errorf("SubtractFunc\nresult: %v\nexpected: %v", result, expected)
+    }
+}
+
+func TestEqual_UnexportedFields(t *testing.T) {
+    list1 := New(person{"a", 1}, person{"b", 2})
+    list2 := New(person{"a", 1}, person{"b", 2})
+    if !Equal(list1, list2) || !Member(list1, person{"b", 2}) {
+        t.Errorf("Equal, Member\nExpected true but got false")
+    }
+}
+
+func TestSubtract_Large(t *testing.T) {
+    list1 := Seq(0, 99999, 1)
+    list2 := Seq(1, 99999, 2)
+    subtract := Subtract(list1, list2)
+    if Len(subtract) != 50000 || !All(subtract, func(n int) bool { return n%2 == 0 }) {
+        t.Errorf("Subtract\nexpected 50000 even numbers")
+    }
+}
+
+func TestSetOperations(t *testing.T) {
+    list1 := New(3, 1, 3, 2, 5)
+    list2 := New(4, 2, 6, 3, 4)
+    results := map[string][]int{
+        "Union":               ToSlice(Union(list1, list2)),
+        "Intersect":           ToSlice(Intersect(list1, list2)),
+        "Difference":          ToSlice(Difference(list1, list2)),
+        "SymmetricDifference": ToSlice(SymmetricDifference(list1, list2)),
+    }
+    expected := map[string][]int{
+        "Union":               {3, 1, 2, 5, 4, 6},
+        "Intersect":           {3, 2},
+        "Difference":          {1, 5},
+        "SymmetricDifference": {1, 5, 4, 6},
+    }
+    if !reflect.DeepEqual(results, expected) {
+        t.Errorf("Set operations\nresult: %v\nexpected: %v", results, expected)
+    }
+}
+
+func TestUniq_UniqBy(t *testing.T) {
+    list := New("b", "a", "B", "c", "a", "A")
+    uniq := Uniq(list)
+    uniqBy := UniqBy(list, strings.ToLower)
+    expected1 := []string{"b", "a", "B", "c", "A"}
+    expected2 := []string{"b", "a", "c"}
+    if result := ToSlice(uniq); !reflect.DeepEqual(result, expected1) {
+        t.Errorf("Uniq\nresult: %v\nexpected: %v", result, expected1)
+    }
+    if result := ToSlice(uniqBy); !reflect.DeepEqual(result, expected2) {
+        t.Errorf("UniqBy\nresult: %v\nexpected: %v", result, expected2)
+    }
+}
+
+func TestMarshalJSON(t *testing.T) {
+    type wrapper struct {
+        Name  string
+        List  GoListC[int]
+        Empty GoListC[string]
+    }
+    data, err := json.Marshal(wrapper{Name: "x", List: New(1, 2, 3)})
+    expected := `{"Name":"x","List":[1,2,3],"Empty":[]}`
+    if err != nil || string(data) != expected {
+        t.Errorf("MarshalJSON\nresult: %s - %v\nexpected: %s", data, err, expected)
+    }
+}
+
+func TestUnmarshalJSON(t *testing.T) {
+    var list GoListC[string]
+    if err := json.Unmarshal([]byte(`["a","b","c"]`), &list); err != nil {
+        t.Fatalf("UnmarshalJSON\nunexpected error: %v", err)
+    }
+    expected := []string{"a", "b", "c"}
+    if result := ToSlice(list); !reflect.DeepEqual(result, expected) {
+        t.Errorf("UnmarshalJSON\nresult: %v\nexpected: %v", result, expected)
+    }
+    if Last(list).Data != "c" {
+        t.Errorf("UnmarshalJSON\nTail: %v\nexpected: c", Last(list))
+    }
+
+    if err := json.Unmarshal([]byte(`null`), &list); err != nil || list.Head != nil {
+        t.Errorf("UnmarshalJSON\nresult: %v - %v\nexpected: []", list, err)
+    }
+    if err := json.Unmarshal([]byte(`{"a":1}`), &list); err == nil {
+        t.Errorf("UnmarshalJSON\nExpected error for JSON object")
+    }
+}
+
+func TestJSON_RoundTrip(t *testing.T) {
+    lists := []GoListC[GoListC[int]]{
+        New(New(1, 2), New[int](), New(3)),
+        New[GoListC[int]](),
+    }
+    for _, list := range lists {
+        data, err := json.Marshal(list)
+        if err != nil {
+            t.Fatalf("MarshalJSON\nunexpected error: %v", err)
+        }
+        var decoded GoListC[GoListC[int]]
+        if err := json.Unmarshal(data, &decoded); err != nil {
+            t.Fatalf("UnmarshalJSON\nunexpected error: %v", err)
+        }
+        eq := func(a, b GoListC[int]) bool { return Equal(a, b) }
+        if !EqualFunc(list, decoded, eq) {
+            t.Errorf("JSON round trip\nresult: %v\nexpected: %v", decoded, list)
+        }
+    }
+}
+
+func TestMarshalBinary_UnmarshalBinary(t *testing.T) {
+    lists := [][]int{{0, 1, 0, -2}, {}}
+    for _, values := range lists {
+        data, err := FromSlice(values).MarshalBinary()
+        if err != nil {
+            t.Fatalf("MarshalBinary\nunexpected error: %v", err)
+        }
+        var decoded GoListC[int]
+        if err := decoded.UnmarshalBinary(data); err != nil {
+            t.Fatalf("UnmarshalBinary\nunexpected error: %v", err)
+        }
+        if result := ToSlice(decoded); !slices.Equal(result, values) {
+            t.Errorf("UnmarshalBinary\nresult: %v\nexpected: %v", result, values)
+        }
+    }
+
+    var decoded GoListC[int]
+    if err := decoded.UnmarshalBinary([]byte("garbage")); err == nil {
+        t.Errorf("UnmarshalBinary\nExpected error for invalid data")
+    }
+
+    broken := New(1, 2, 3)
+    broken.Tail.Next = broken.Head.Next
+    if _, err := broken.MarshalBinary(); !errors.Is(err, ErrCorrupted) {
+        t.Errorf("MarshalBinary\nresult: %v\nexpected: %v", err, ErrCorrupted)
+    }
+}
+
+func TestGob_RoundTrip(t *testing.T) {
+    type wrapper struct {
+        Name  string
+        Lists GoListC[GoListC[string]]
+        Big   GoListC[int]
+    }
+    input := wrapper{
+        Name:  "x",
+        Lists: New(New("a", "b"), New[string](), New("c")),
+        Big:   Seq(1, 100000, 1),
+    }
+
+    var buffer bytes.Buffer
+    if err := gob.NewEncoder(&buffer).Encode(input); err != nil {
+        t.Fatalf("GobEncode\nunexpected error: %v", err)
+    }
+    var output wrapper
+    if err := gob.NewDecoder(&buffer).Decode(&output); err != nil {
+        t.Fatalf("GobDecode\nunexpected error: %v", err)
+    }
+
+    eq := func(a, b GoListC[string]) bool { return Equal(a, b) }
+    if output.Name != "x" || !EqualFunc(input.Lists, output.Lists, eq) {
+        t.Errorf("Gob round trip\nresult: %v\nexpected: %v", output.Lists, input.Lists)
+    }
+    if !Equal(input.Big, output.Big) {
+        t.Errorf("Gob round trip\nBig list is not decoded correctly")
+    }
+}
+
+func TestParse_RoundTrip(t *testing.T) {
+    numbers := New(-1, 0, 42, -7)
+    if result, err := ParseNumbers[int](numbers.String()); err != nil || !Equal(result, numbers) {
+        t.Errorf("ParseNumbers\nresult: %v, %v\nexpected: %v", result, err, numbers)
+    }
+    floats := New(1.5, -2.25, 1e21)
+    if result, err := ParseNumbers[float64](floats.String()); err != nil || !Equal(result, floats) {
+        t.Errorf("ParseNumbers\nresult: %v, %v\nexpected: %v", result, err, floats)
+    }
+    strs := New("a=>b", "\"quoted\"", "]", "", "back\\slash")
+    if result, err := ParseStrings(strs.String()); err != nil || !Equal(result, strs) {
+        t.Errorf("ParseStrings\nresult: %v, %v\nexpected: %v", result, err, strs)
+    }
+    if result, err := ParseNumbers[int]("[]"); err != nil || result.Head != nil {
+        t.Errorf("ParseNumbers\nresult: %v, %v\nexpected: []", result, err)
+    }
+}
+
+func TestParse_Nested(t *testing.T) {
+    nested := New(New(1, 2), New[int](), New(3))
+    result, err := Parse(nested.String(), ParseNumbers[int])
+    if err != nil {
+        t.Fatalf("Parse\nunexpected error: %v", err)
+    }
+    eq := func(a, b GoListC[int]) bool { return Equal(a, b) }
+    if !EqualFunc(result, nested, eq) {
+        t.Errorf("Parse\nresult: %v\nexpected: %v", result, nested)
+    }
+}
+
+func TestParse_Errors(t *testing.T) {
+    inputs := []string{"", "1=>2]", "[1=>2", "[1=>=>2]", "[1=>2] x", "[1=>abc=>]", "[1 2=>]"}
+    for _, input := range inputs {
+        if _, err := ParseNumbers[int](input); !errors.Is(err, ErrSyntax) {
+            t.Errorf("ParseNumbers(%q)\nresult: %v\nexpected: %v", input, err, ErrSyntax)
+        }
+    }
+    if _, err := ParseStrings(`["abc=>]`); !errors.Is(err, ErrSyntax) {
+        t.Errorf("ParseStrings\nresult: %v\nexpected: %v", err, ErrSyntax)
+    }
+    if result, err := ParseNumbers[int]("[1=>2=>] \n"); err != nil || !Equal(result, New(1, 2)) {
+        t.Errorf("ParseNumbers\nresult: %v, %v\nexpected: [1 2]", result, err)
+    }
+}
+
+func TestWriteTo(t *testing.T) {
+    list := New("x", "y=>z")
+    var builder strings.Builder
+    n, err := list.WriteTo(&builder)
+    if expected := list.String(); err != nil || builder.String() != expected || n != int64(len(expected)) {
+        t.Errorf("WriteTo\nresult: %q, %d, %v\nexpected: %q", builder.String(), n, err, expected)
+    }
+    if expected := `["x"=>"y=>z"=>]`; builder.String() != expected {
+        t.Errorf("WriteTo\nresult: %v\nexpected: %v", builder.String(), expected)
+    }
+}
+
+func TestFormat(t *testing.T) {
+    numbers, strs, empty := Seq(1, 5, 1), New("a", "b"), New[int]()
+    nested := New(New(1), New[int]())
+    tests := []struct {
+        format   string
+        list     any
+        expected string
+    }{
+        {"%v", numbers, `[1=>2=>3=>4=>5=>]`},
+        {"%+v", numbers, `[0:1=>1:2=>2:3=>3:4=>4:5=>]`},
+        {"%#v", numbers, `golistc.New(1, 2, 3, 4, 5)`},
+        {"%.2v", numbers, `[1=>2=>...(5 total)]`},
+        {"%+.2v", numbers, `[0:1=>1:2=>...(5 total)]`},
+        {"%#.2v", numbers, `golistc.New(1, 2, ... /* 5 total */)`},
+        {"%.0v", numbers, `[...(5 total)]`},
+        {"%.10v", numbers, `[1=>2=>3=>4=>5=>]`},
+        {"%02x", numbers, `[01=>02=>03=>04=>05=>]`},
+        {"%v", strs, `["a"=>"b"=>]`},
+        {"%s", strs, `["a"=>"b"=>]`},
+        {"%+v", New("é", "日本"), `[0:"é"=>1:"日本"=>]`},
+        {"%#v", strs, `golistc.New("a", "b")`},
+        {"%#v", empty, `golistc.New[int]()`},
+        {"%.3v", empty, `[]`},
+        {"%#v", nested, `golistc.New(golistc.New(1), golistc.New[int]())`},
+    }
+    for _, test := range tests {
+        if result := fmt.Sprintf(test.format, test.list); result != test.expected {
+            t.Errorf("Format %s\nresult: %v\nexpected: %v", test.format, result, test.expected)
+        }
+    }
+}
+
+func TestFormat_String(t *testing.T) {
+    list := Seq(1, 100, 1)
+    if result, expected := fmt.Sprint(list), list.String(); result != expected {
+        t.Errorf("Format\nresult: %v\nexpected: %v", result, expected)
+    }
+}
+
+func TestValidate(t *testing.T) {
+    if err := Validate(New(1, 2, 3)); err != nil {
+        t.Errorf("Validate\nunexpected error: %v", err)
+    }
+    if err := Validate(New[int]()); err != nil {
+        t.Errorf("Validate\nunexpected error: %v", err)
+    }
+
+    open := New(1, 2, 3)
+    open.Tail.Next = nil
+    looped := New(1, 2, 3, 4)
+    looped.Tail.Next = looped.Head.Next
+    badTail := New(1, 2, 3)
+    badTail.Tail = badTail.Head.Next
+    emptyTail := GoListC[int]{Tail: New(1).Head}
+    tests := map[string]GoListC[int]{
+        "do not return to Head":  open,
+        "cycle starts at node 1": looped,
+        "Tail is not the last":   badTail,
+        "Tail of empty list":     emptyTail,
+    }
+    for message, list := range tests {
+        err := Validate(list)
+        if !errors.Is(err, ErrCorrupted) || !strings.Contains(err.Error(), message) {
+            t.Errorf("Validate\nresult: %v\nexpected: %v", err, message)
+        }
+    }
+}
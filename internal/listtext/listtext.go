@@ -0,0 +1,227 @@
+// Package listtext contains the text format shared by the lists of this
+// module, such as ["a"->"b"], to write a list as text and parse it back.
+package listtext
+
+import (
+    "bufio"
+    "fmt"
+    "io"
+    "iter"
+    "strings"
+    "unicode"
+    "github.com/hiennguyen-neih/go-linkedlist/constraints"
+)
+
+/*
+ *******************************************************************************
+ * Define structs and interfaces
+ *******************************************************************************
+ */
+
+// Text format of a list: node data between brackets, separated by Sep.
+type Format struct {
+    Sep       string    // Separator between node data.
+    Trailing  bool      // Separator also follows the last node data.
+    ErrSyntax error     // Error wrapped by every syntax error.
+}
+
+/*
+ *******************************************************************************
+ * Exported functions
+ *******************************************************************************
+ */
+
+// Decodes token formatted by %v into a number. Returns an error wrapping
+// errSyntax if token is not a number.
+func ParseNumber[T constraints.Numeric](token string, errSyntax error) (T, error) {
+    var value T
+    if _, err := fmt.Sscanf(token+"\n", "%v\n", &value); err != nil {
+        return value, fmt.Errorf("%w: invalid number %q", errSyntax, token)
+    }
+    return value, nil
+}
+
+// Parses text of a list in format from r, calling add with every node data
+// decoded by decode, from head to tail. The reader is consumed incrementally,
+// so the whole text is never held in memory. Only white spaces may follow the
+// closing bracket. Returns an error wrapping format.ErrSyntax if the text is
+// not in format, or the error returned by decode.
+func Read[T any](format Format, r io.Reader, decode func(string) (T, error), add func(T)) error {
+    reader := bufio.NewReader(r)
+    if err := format.expect(reader, "["); err != nil {
+        return err
+    }
+    for !accept(reader, "]") {
+        token, err := format.readToken(reader)
+        if err != nil {
+            return err
+        }
+        value, err := decode(token)
+        if err != nil {
+            return err
+        }
+        add(value)
+
+        if format.Trailing {
+            if err := format.expect(reader, format.Sep); err != nil {
+                return err
+            }
+            if accept(reader, "]") {
+                break
+            }
+        } else {
+            if accept(reader, "]") {
+                break
+            }
+            if err := format.expect(reader, format.Sep); err != nil {
+                return err
+            }
+        }
+    }
+    return format.expectEnd(reader)
+}
+
+// Writes text of the node data of seq in format into w, node by node, without
+// building the whole string in memory. Strings are quoted. Returns the number
+// of bytes written.
+func Write[T any](format Format, w io.Writer, seq iter.Seq[T]) (int64, error) {
+    counter := &countWriter{w: w}
+    builder := bufio.NewWriter(counter)
+    builder.WriteString("[")
+    first := true
+    for value := range seq {
+        if !first && !format.Trailing {
+            builder.WriteString(format.Sep)
+        }
+        first = false
+
+        var data any = value
+        if str, ok := data.(string); ok {
+            fmt.Fprintf(builder, "%q", str)
+        } else {
+            fmt.Fprintf(builder, "%v", value)
+        }
+        if format.Trailing {
+            builder.WriteString(format.Sep)
+        }
+    }
+    builder.WriteString("]")
+    err := builder.Flush()
+    return counter.n, err
+}
+
+/*
+ *******************************************************************************
+ * Internal functions and methods
+ *******************************************************************************
+ */
+
+// Writer counting the bytes written into w.
+type countWriter struct {
+    w io.Writer
+    n int64
+}
+
+func (writer *countWriter) Write(p []byte) (int, error) {
+    n, err := writer.w.Write(p)
+    writer.n += int64(n)
+    return n, err
+}
+
+// Consumes str from reader if the reader continues with it. Returns true if
+// str is consumed.
+func accept(reader *bufio.Reader, str string) bool {
+    if peek, _ := reader.Peek(len(str)); string(peek) != str {
+        return false
+    }
+    reader.Discard(len(str))
+    return true
+}
+
+// Consumes str from reader, or returns an error if the reader does not
+// continue with it.
+func (format Format) expect(reader *bufio.Reader, str string) error {
+    if !accept(reader, str) {
+        return fmt.Errorf("%w: expected %q", format.ErrSyntax, str)
+    }
+    return nil
+}
+
+// Returns an error if reader has other characters than white spaces left.
+func (format Format) expectEnd(reader *bufio.Reader) error {
+    for {
+        char, _, err := reader.ReadRune()
+        if err == io.EOF {
+            return nil
+        } else if err != nil {
+            return err
+        }
+        if !unicode.IsSpace(char) {
+            return fmt.Errorf("%w: unexpected %q after list", format.ErrSyntax, char)
+        }
+    }
+}
+
+// Reads text of one node data from reader, stopping before the separator or
+// the closing bracket of the list. Quoted strings and nested brackets are read
+// as a whole, so they may contain the separator.
+func (format Format) readToken(reader *bufio.Reader) (string, error) {
+    var token strings.Builder
+    depth := 0 // depth of nested brackets
+    for {
+        if depth == 0 {
+            if peek, _ := reader.Peek(len(format.Sep)); string(peek) == format.Sep {
+                break
+            }
+            if peek, _ := reader.Peek(1); string(peek) == "]" {
+                break
+            }
+        }
+        char, _, err := reader.ReadRune()
+        if err == io.EOF {
+            return "", fmt.Errorf("%w: unexpected end of list", format.ErrSyntax)
+        } else if err != nil {
+            return "", err
+        }
+        token.WriteRune(char)
+
+        switch char {
+        case '[', '{', '(':
+            depth++
+        case ']', '}', ')':
+            depth--
+        case '"':
+            if err := format.readQuoted(reader, &token); err != nil {
+                return "", err
+            }
+        }
+    }
+    if token.Len() == 0 {
+        return "", fmt.Errorf("%w: empty node data", format.ErrSyntax)
+    }
+    return token.String(), nil
+}
+
+// Reads the rest of a quoted string from reader into token, up to and
+// including the closing quote.
+func (format Format) readQuoted(reader *bufio.Reader, token *strings.Builder) error {
+    escaped := false
+    for {
+        char, _, err := reader.ReadRune()
+        if err == io.EOF {
+            return fmt.Errorf("%w: unterminated string", format.ErrSyntax)
+        } else if err != nil {
+            return err
+        }
+        token.WriteRune(char)
+
+        switch {
+        case escaped:
+            escaped = false
+        case char == '\\':
+            escaped = true
+        case char == '"':
+            return nil
+        }
+    }
+}
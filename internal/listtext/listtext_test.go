@@ -0,0 +1,47 @@
+package listtext
+
+import (
+    "errors"
+    "slices"
+    "strconv"
+    "strings"
+    "testing"
+)
+
+var errSyntax = errors.New("test: invalid list syntax")
+
+func TestWrite_Read(t *testing.T) {
+    formats := map[string]Format{
+        `["a"->"b,c"->"[d]"]`:   {Sep: "->", ErrSyntax: errSyntax},
+        `["a"=>"b,c"=>"[d]"=>]`: {Sep: "=>", Trailing: true, ErrSyntax: errSyntax},
+    }
+    values := []string{"a", "b,c", "[d]"}
+    for expected, format := range formats {
+        var builder strings.Builder
+        n, err := Write(format, &builder, slices.Values(values))
+        if result := builder.String(); err != nil || result != expected || n != int64(len(expected)) {
+            t.Errorf("Write\nresult: %v (%v bytes)\nexpected: %v", result, n, expected)
+        }
+
+        var parsed []string
+        add := func(value string) { parsed = append(parsed, value) }
+        if err := Read(format, strings.NewReader(expected), strconv.Unquote, add); err != nil {
+            t.Fatalf("Read\nunexpected error: %v", err)
+        }
+        if !slices.Equal(parsed, values) {
+            t.Errorf("Read\nresult: %v\nexpected: %v", parsed, values)
+        }
+    }
+}
+
+func TestRead_Error(t *testing.T) {
+    format := Format{Sep: "->", ErrSyntax: errSyntax}
+    decode := func(token string) (int, error) { return ParseNumber[int](token, errSyntax) }
+    inputs := []string{"", "1->2]", "[1->2", "[1->->2]", "[1->x]", "[1] 2", `["a]`}
+    for _, input := range inputs {
+        err := Read(format, strings.NewReader(input), decode, func(int) {})
+        if !errors.Is(err, errSyntax) {
+            t.Errorf("Read(%q)\nresult: %v\nexpected: %v", input, err, errSyntax)
+        }
+    }
+}
@@ -0,0 +1,118 @@
+package lockfree
+
+import (
+    "sync/atomic"
+)
+
+// Node of Queue. Unlike node.Node, next is changed after the node is shared
+// between goroutines, so it is an atomic pointer. So is data, which is cleared
+// when the node becomes the dummy head while Peek may still read it.
+type queueNode[T any] struct {
+    data atomic.Pointer[T]
+    next atomic.Pointer[queueNode[T]]
+}
+
+// Michael-Scott queue. The zero value is an empty queue ready to use. A Queue
+// must not be copied after first use.
+//
+// Head of the queue is always a dummy node, data of the front element is held
+// in the node after it.
+type Queue[T any] struct {
+    head atomic.Pointer[queueNode[T]]
+    tail atomic.Pointer[queueNode[T]]
+    size atomic.Int64
+}
+
+// Returns a new queue containing all input elements, the first one at front.
+func NewQueue[T any](elems ...T) *Queue[T] {
+    queue := &Queue[T]{}
+    for _, elem := range elems {
+        queue.Enqueue(elem)
+    }
+    return queue
+}
+
+// Removes the front element of the queue. Returns it and true, or zero value
+// and false if the queue is empty.
+func (queue *Queue[T]) Dequeue() (T, bool) {
+    queue.init()
+    for {
+        head, tail := queue.head.Load(), queue.tail.Load()
+        next := head.next.Load()
+        if head != queue.head.Load() {
+            continue
+        }
+        if next == nil {
+            var zero T
+            return zero, false
+        }
+        if head == tail {
+            // Tail is falling behind, help the enqueuer advancing it.
+            queue.tail.CompareAndSwap(tail, next)
+            continue
+        }
+        if queue.head.CompareAndSwap(head, next) {
+            // next is the new dummy node. Only the winner of the CAS takes its
+            // data, which is cleared so the queue does not keep it alive.
+            value := next.data.Swap(nil)
+            queue.size.Add(-1)
+            return *value, true
+        }
+    }
+}
+
+// Inserts value at the back of the queue.
+func (queue *Queue[T]) Enqueue(value T) {
+    queue.init()
+    node := &queueNode[T]{}
+    node.data.Store(&value)
+    for {
+        tail := queue.tail.Load()
+        next := tail.next.Load()
+        if tail != queue.tail.Load() {
+            continue
+        }
+        if next != nil {
+            queue.tail.CompareAndSwap(tail, next)
+            continue
+        }
+        if tail.next.CompareAndSwap(nil, node) {
+            queue.tail.CompareAndSwap(tail, node)
+            queue.size.Add(1)
+            return
+        }
+    }
+}
+
+// Returns approximate number of elements of the queue. The count is updated
+// after each enqueue and dequeue, so it may lag behind concurrent operations.
+func (queue *Queue[T]) Len() int {
+    return int(max(queue.size.Load(), 0))
+}
+
+// Returns the front element of the queue and true, or zero value and false if
+// the queue is empty.
+func (queue *Queue[T]) Peek() (T, bool) {
+    queue.init()
+    for {
+        next := queue.head.Load().next.Load()
+        if next == nil {
+            var zero T
+            return zero, false
+        }
+        if value := next.data.Load(); value != nil {
+            return *value, true
+        }
+        // next has just been dequeued, retry with the new head.
+    }
+}
+
+// Do create the dummy node of a zero value queue. Safe to call concurrently,
+// every goroutine sees both head and tail set when it returns.
+func (queue *Queue[T]) init() {
+    if queue.tail.Load() != nil {
+        return
+    }
+    queue.head.CompareAndSwap(nil, &queueNode[T]{})
+    queue.tail.CompareAndSwap(nil, queue.head.Load())
+}
@@ -0,0 +1,147 @@
+package lockfree
+
+import (
+    "sync"
+    "testing"
+    "github.com/hiennguyen-neih/go-linkedlist/golist2/concurrent"
+)
+
+func TestQueue(t *testing.T) {
+    queue := NewQueue(1, 2, 3)
+    if result, _ := queue.Peek(); result != 1 {
+        t.Errorf("Peek\nresult: %v\nexpected: %v", result, 1)
+    }
+    for expected := 1; expected <= 3; expected++ {
+        if result, ok := queue.Dequeue(); !ok || result != expected {
+            t.Errorf("Dequeue\nresult: %v, %v\nexpected: %v, true", result, ok, expected)
+        }
+    }
+    if _, ok := queue.Dequeue(); ok || queue.Len() != 0 {
+        t.Errorf("Dequeue\nresult: %v, %v\nexpected: false, 0", ok, queue.Len())
+    }
+}
+
+func TestQueue_ZeroValue(t *testing.T) {
+    var queue Queue[string]
+    if _, ok := queue.Dequeue(); ok {
+        t.Errorf("Dequeue\nresult: true\nexpected: false")
+    }
+    queue.Enqueue("a")
+    if result, ok := queue.Peek(); !ok || result != "a" {
+        t.Errorf("Peek\nresult: %v, %v\nexpected: a, true", result, ok)
+    }
+}
+
+func TestQueue_DequeueClearsData(t *testing.T) {
+    queue := NewQueue("a", "b")
+    queue.Dequeue()
+    if data := queue.head.Load().data.Load(); data != nil {
+        t.Errorf("Dequeue\nresult: %v\nexpected: nil", *data)
+    }
+}
+
+func TestQueue_ConcurrentPeek(t *testing.T) {
+    queue := NewQueue[int]()
+    for i := 0; i < perWorker; i++ {
+        queue.Enqueue(i)
+    }
+    var wg sync.WaitGroup
+    wg.Add(2)
+    go func() {
+        defer wg.Done()
+        for _, ok := queue.Dequeue(); ok; _, ok = queue.Dequeue() {
+        }
+    }()
+    go func() {
+        defer wg.Done()
+        previous := -1
+        for value, ok := queue.Peek(); ok; value, ok = queue.Peek() {
+            if value < previous {
+                t.Errorf("Peek\nresult: %v\nexpected: >= %v", value, previous)
+                return
+            }
+            previous = value
+        }
+    }()
+    wg.Wait()
+}
+
+func TestQueue_Concurrent(t *testing.T) {
+    var queue Queue[int]
+    var producers, consumers sync.WaitGroup
+    results := make([][]int, workers)
+    for w := 0; w < workers; w++ {
+        producers.Add(1)
+        go func(w int) {
+            defer producers.Done()
+            for i := 0; i < perWorker; i++ {
+                queue.Enqueue(w*perWorker + i)
+            }
+        }(w)
+    }
+    done := make(chan struct{})
+    for w := 0; w < workers; w++ {
+        consumers.Add(1)
+        go func(w int) {
+            defer consumers.Done()
+            for {
+                value, ok := queue.Dequeue()
+                if ok {
+                    results[w] = append(results[w], value)
+                    continue
+                }
+                select {
+                case <-done:
+                    if queue.Len() == 0 {
+                        return
+                    }
+                default:
+                }
+            }
+        }(w)
+    }
+    producers.Wait()
+    close(done)
+    consumers.Wait()
+
+    // Each consumer sees values of each producer in order.
+    seen := make([]bool, workers*perWorker)
+    for _, values := range results {
+        last := make([]int, workers)
+        for i := range last {
+            last[i] = -1
+        }
+        for _, value := range values {
+            producer, index := value/perWorker, value%perWorker
+            if index <= last[producer] || seen[value] {
+                t.Fatalf("Dequeue\nvalue %v is out of order or duplicated", value)
+            }
+            last[producer], seen[value] = index, true
+        }
+    }
+    for value, ok := range seen {
+        if !ok {
+            t.Fatalf("Dequeue\nvalue %v is lost", value)
+        }
+    }
+}
+
+func BenchmarkQueue(b *testing.B) {
+    var queue Queue[int]
+    b.RunParallel(func(pb *testing.PB) {
+        for pb.Next() {
+            queue.Enqueue(1)
+            queue.Dequeue()
+        }
+    })
+}
+
+func BenchmarkQueue_Mutex(b *testing.B) {
+    var list concurrent.List[int]
+    b.RunParallel(func(pb *testing.PB) {
+        for pb.Next() {
+            list.PushBack(1)
+            list.PopFront()
+        }
+    })
+}
@@ -0,0 +1,88 @@
+// Package lockfree contains a stack and a queue that are safe for concurrent
+// use by multiple goroutines without locks, using sync/atomic pointers.
+package lockfree
+
+import (
+    "sync/atomic"
+    "github.com/hiennguyen-neih/go-linkedlist/node"
+)
+
+// Treiber stack of node.Node. The zero value is an empty stack ready to use.
+// A Stack must not be copied after first use.
+//
+// Next pointer of a node is set before the node is published and never
+// changed afterward, so plain node.Node can be shared between goroutines.
+type Stack[T any] struct {
+    head atomic.Pointer[node.Node[T]]
+    size atomic.Int64
+}
+
+// Returns a new stack containing all input elements, the last one on top.
+func NewStack[T any](elems ...T) *Stack[T] {
+    stack := &Stack[T]{}
+    for _, elem := range elems {
+        stack.Push(elem)
+    }
+    return stack
+}
+
+// Returns approximate number of elements of the stack. The count is updated
+// after each push and pop, so it may lag behind concurrent operations.
+func (stack *Stack[T]) Len() int {
+    return int(max(stack.size.Load(), 0))
+}
+
+// Returns the top element of the stack and true, or zero value and false if
+// the stack is empty.
+func (stack *Stack[T]) Peek() (T, bool) {
+    head := stack.head.Load()
+    if head == nil {
+        var zero T
+        return zero, false
+    }
+    return head.Data, true
+}
+
+// Removes the top element of the stack. Returns it and true, or zero value and
+// false if the stack is empty. Retries while other goroutines win the race on
+// the top of the stack.
+func (stack *Stack[T]) Pop() (T, bool) {
+    for {
+        if value, ok, done := stack.tryPop(); done {
+            return value, ok
+        }
+    }
+}
+
+// Pushes value on top of the stack.
+func (stack *Stack[T]) Push(value T) {
+    node := &node.Node[T]{Data: value}
+    for {
+        node.Next = stack.head.Load()
+        if stack.head.CompareAndSwap(node.Next, node) {
+            stack.size.Add(1)
+            return
+        }
+    }
+}
+
+// Same as Pop, but makes a single attempt. Returns zero value and false if the
+// stack is empty or another goroutine changed the top of the stack meanwhile.
+func (stack *Stack[T]) TryPop() (T, bool) {
+    value, ok, _ := stack.tryPop()
+    return value, ok
+}
+
+// Do one attempt to pop the top element. Returns done false if the attempt
+// lost the race and should be retried.
+func (stack *Stack[T]) tryPop() (value T, ok bool, done bool) {
+    head := stack.head.Load()
+    if head == nil {
+        return value, false, true
+    }
+    if !stack.head.CompareAndSwap(head, head.Next) {
+        return value, false, false
+    }
+    stack.size.Add(-1)
+    return head.Data, true, true
+}
@@ -0,0 +1,97 @@
+package lockfree
+
+import (
+    "slices"
+    "sync"
+    "testing"
+    "github.com/hiennguyen-neih/go-linkedlist/golist2/concurrent"
+)
+
+const (
+    workers   = 8
+    perWorker = 10000
+)
+
+func TestStack(t *testing.T) {
+    stack := NewStack(1, 2, 3)
+    if result, _ := stack.Peek(); result != 3 {
+        t.Errorf("Peek\nresult: %v\nexpected: %v", result, 3)
+    }
+    var result []int
+    for value, ok := stack.Pop(); ok; value, ok = stack.Pop() {
+        result = append(result, value)
+    }
+    if expected := []int{3, 2, 1}; !slices.Equal(result, expected) {
+        t.Errorf("Pop\nresult: %v\nexpected: %v", result, expected)
+    }
+    if _, ok := stack.TryPop(); ok || stack.Len() != 0 {
+        t.Errorf("TryPop\nresult: %v, %v\nexpected: false, 0", ok, stack.Len())
+    }
+}
+
+func TestStack_ZeroValue(t *testing.T) {
+    var stack Stack[string]
+    if _, ok := stack.Peek(); ok {
+        t.Errorf("Peek\nresult: true\nexpected: false")
+    }
+    stack.Push("a")
+    if result, ok := stack.TryPop(); !ok || result != "a" {
+        t.Errorf("TryPop\nresult: %v, %v\nexpected: a, true", result, ok)
+    }
+}
+
+func TestStack_Concurrent(t *testing.T) {
+    var stack Stack[int]
+    results := make([][]int, workers)
+    var wg sync.WaitGroup
+    for w := 0; w < workers; w++ {
+        wg.Add(1)
+        go func(w int) {
+            defer wg.Done()
+            for i := 0; i < perWorker; i++ {
+                stack.Push(w*perWorker + i)
+                if i%2 == 1 {
+                    value, _ := stack.Pop()
+                    results[w] = append(results[w], value)
+                }
+            }
+        }(w)
+    }
+    wg.Wait()
+
+    if result, expected := stack.Len(), workers*perWorker/2; result != expected {
+        t.Errorf("Len\nresult: %v\nexpected: %v", result, expected)
+    }
+    for value, ok := stack.Pop(); ok; value, ok = stack.Pop() {
+        results[0] = append(results[0], value)
+    }
+    values := slices.Sorted(slices.Values(slices.Concat(results...)))
+    for i, value := range values {
+        if i != value {
+            t.Fatalf("Pop\nresult: %v\nexpected: %v", value, i)
+        }
+    }
+    if len(values) != workers*perWorker {
+        t.Errorf("Pop\nresult: %v values\nexpected: %v values", len(values), workers*perWorker)
+    }
+}
+
+func BenchmarkStack(b *testing.B) {
+    var stack Stack[int]
+    b.RunParallel(func(pb *testing.PB) {
+        for pb.Next() {
+            stack.Push(1)
+            stack.Pop()
+        }
+    })
+}
+
+func BenchmarkStack_Mutex(b *testing.B) {
+    var list concurrent.List[int]
+    b.RunParallel(func(pb *testing.PB) {
+        for pb.Next() {
+            list.PushFront(1)
+            list.PopFront()
+        }
+    })
+}
@@ -0,0 +1,179 @@
+// Package lru contains a least recently used cache on doubly linked list in
+// Go, with O(1) lookups, insertions and evictions.
+package lru
+
+import (
+    "iter"
+    "github.com/hiennguyen-neih/go-linkedlist/golist2"
+    "github.com/hiennguyen-neih/go-linkedlist/node"
+)
+
+/*
+ *******************************************************************************
+ * Define structs and interfaces
+ *******************************************************************************
+ */
+
+// Entry of the cache, stored as data of list nodes.
+type entry[K comparable, V any] struct {
+    key   K
+    value V
+}
+
+// Cache holding at most a fixed number of entries. When it is full, putting a
+// new entry evicts the least recently used one. Entries are kept in a GoList2
+// from most to least recently used, with a map from keys to their nodes. Use
+// New to create one. Cache is not safe for concurrent use, see SyncCache.
+type Cache[K comparable, V any] struct {
+    list     golist2.GoList2[entry[K, V]]
+    nodes    map[K]*node.Node2[entry[K, V]]
+    capacity int
+    onEvict  func(K, V)
+}
+
+/*
+ *******************************************************************************
+ * Exported functions
+ *******************************************************************************
+ */
+
+// Returns a new empty cache holding at most capacity entries. Panics if
+// capacity is not positive.
+func New[K comparable, V any](capacity int) *Cache[K, V] {
+    if capacity <= 0 {
+        panic("New, capacity must be positive!")
+    }
+    return &Cache[K, V]{
+        nodes:    make(map[K]*node.Node2[entry[K, V]]),
+        capacity: capacity,
+    }
+}
+
+/*
+ *******************************************************************************
+ * Exported methods
+ *******************************************************************************
+ */
+
+// Returns an iterator over entries of the cache, from most to least recently
+// used. Iterating does not change the order of entries.
+func (cache *Cache[K, V]) All() iter.Seq2[K, V] {
+    return func(yield func(K, V) bool) {
+        for entry := range cache.list.All() {
+            if !yield(entry.key, entry.value) {
+                return
+            }
+        }
+    }
+}
+
+// Returns maximum number of entries of the cache.
+func (cache *Cache[K, V]) Cap() int {
+    return cache.capacity
+}
+
+// Returns value of key and true, or zero value and false if key is not in the
+// cache. Marks key as the most recently used.
+func (cache *Cache[K, V]) Get(key K) (V, bool) {
+    node, ok := cache.nodes[key]
+    if !ok {
+        var zero V
+        return zero, false
+    }
+    cache.list.MoveToFront(node)
+    return node.Data.value, true
+}
+
+// Returns keys of the cache, from most to least recently used.
+func (cache *Cache[K, V]) Keys() iter.Seq[K] {
+    return func(yield func(K) bool) {
+        for key := range cache.All() {
+            if !yield(key) {
+                return
+            }
+        }
+    }
+}
+
+// Returns number of entries of the cache.
+func (cache *Cache[K, V]) Len() int {
+    return cache.list.Size
+}
+
+// Sets fun to be called with key and value of each entry evicted because the
+// cache is full or resized. It is not called by Remove.
+func (cache *Cache[K, V]) OnEvict(fun func(key K, value V)) {
+    cache.onEvict = fun
+}
+
+// Same as Get, but does not mark key as the most recently used.
+func (cache *Cache[K, V]) Peek(key K) (V, bool) {
+    node, ok := cache.nodes[key]
+    if !ok {
+        var zero V
+        return zero, false
+    }
+    return node.Data.value, true
+}
+
+/*
+ *******************************************************************************
+ * Exported in-place methods
+ *******************************************************************************
+ */
+
+// Inserts key with value as the most recently used entry, replacing the value
+// if key is already in the cache. Returns true if an entry was evicted to make
+// room for it.
+func (cache *Cache[K, V]) Put(key K, value V) bool {
+    if node, ok := cache.nodes[key]; ok {
+        node.Data.value = value
+        cache.list.MoveToFront(node)
+        return false
+    }
+    cache.nodes[key] = cache.list.PushFront(entry[K, V]{key, value})
+    return cache.evict() > 0
+}
+
+// Removes key from the cache. Returns true if key was in the cache.
+func (cache *Cache[K, V]) Remove(key K) bool {
+    node, ok := cache.nodes[key]
+    if !ok {
+        return false
+    }
+    cache.list.Remove(node)
+    delete(cache.nodes, key)
+    return true
+}
+
+// Changes maximum number of entries of the cache, evicting the least recently
+// used entries if it holds more. Returns number of evicted entries. Panics if
+// capacity is not positive.
+func (cache *Cache[K, V]) Resize(capacity int) int {
+    if capacity <= 0 {
+        panic("Resize, capacity must be positive!")
+    }
+    cache.capacity = capacity
+    return cache.evict()
+}
+
+/*
+ *******************************************************************************
+ * Internal functions and methods
+ *******************************************************************************
+ */
+
+// Do evict least recently used entries until the cache fits its capacity.
+// Returns number of evicted entries.
+func (cache *Cache[K, V]) evict() int {
+    count := 0
+    for cache.list.Size > cache.capacity {
+        entry, _ := cache.list.PopBack()
+        delete(cache.nodes, entry.key)
+        if cache.onEvict != nil {
+            cache.onEvict(entry.key, entry.value)
+        }
+        count++
+    }
+    return count
+}
@@ -0,0 +1,114 @@
+package lru
+
+import (
+    "slices"
+    "testing"
+)
+
+// Checks that the map and the list of cache hold the same entries.
+func checkInvariants[K comparable, V any](t *testing.T, name string, cache *Cache[K, V]) {
+    t.Helper()
+    if len(cache.nodes) != cache.list.Size || cache.list.Size > cache.capacity {
+        t.Errorf("%s\nmap: %v, list: %v, capacity: %v", name, len(cache.nodes), cache.list.Size, cache.capacity)
+    }
+    for node := cache.list.Head; node != nil; node = node.Next {
+        if cache.nodes[node.Data.key] != node {
+            t.Errorf("%s\nkey %v is not mapped to its node", name, node.Data.key)
+        }
+    }
+}
+
+func TestGetPut(t *testing.T) {
+    cache := New[string, int](2)
+    cache.Put("a", 1)
+    cache.Put("b", 2)
+    if value, ok := cache.Get("a"); !ok || value != 1 {
+        t.Errorf("Get\nresult: %v, %v\nexpected: 1, true", value, ok)
+    }
+    if !cache.Put("c", 3) {
+        t.Errorf("Put\nresult: false\nexpected: true")
+    }
+    if _, ok := cache.Get("b"); ok {
+        t.Errorf("Get\nresult: true\nexpected: false")
+    }
+    if cache.Put("a", 10) {
+        t.Errorf("Put\nresult: true\nexpected: false")
+    }
+    if value, _ := cache.Peek("a"); value != 10 {
+        t.Errorf("Peek\nresult: %v\nexpected: %v", value, 10)
+    }
+    checkInvariants(t, "Put", cache)
+    if cache.Len() != 2 || cache.Cap() != 2 {
+        t.Errorf("Len\nresult: %v, %v\nexpected: 2, 2", cache.Len(), cache.Cap())
+    }
+}
+
+func TestPeek(t *testing.T) {
+    cache := New[int, int](2)
+    cache.Put(1, 1)
+    cache.Put(2, 2)
+    cache.Peek(1)
+    cache.Put(3, 3)
+    if _, ok := cache.Peek(1); ok {
+        t.Errorf("Peek\nresult: true\nexpected: false")
+    }
+}
+
+func TestAll(t *testing.T) {
+    cache := New[int, string](3)
+    cache.Put(1, "a")
+    cache.Put(2, "b")
+    cache.Put(3, "c")
+    cache.Get(1)
+    if result, expected := slices.Collect(cache.Keys()), []int{1, 3, 2}; !slices.Equal(result, expected) {
+        t.Errorf("Keys\nresult: %v\nexpected: %v", result, expected)
+    }
+    var values []string
+    for _, value := range cache.All() {
+        values = append(values, value)
+    }
+    if expected := []string{"a", "c", "b"}; !slices.Equal(values, expected) {
+        t.Errorf("All\nresult: %v\nexpected: %v", values, expected)
+    }
+}
+
+func TestRemoveResize(t *testing.T) {
+    var evicted []int
+    cache := New[int, int](5)
+    cache.OnEvict(func(key, value int) {
+        evicted = append(evicted, key)
+    })
+    for i := 1; i <= 5; i++ {
+        cache.Put(i, i)
+    }
+    if !cache.Remove(3) || cache.Remove(3) {
+        t.Errorf("Remove\nresult: false\nexpected: true")
+    }
+    if result := cache.Resize(2); result != 2 {
+        t.Errorf("Resize\nresult: %v\nexpected: %v", result, 2)
+    }
+    checkInvariants(t, "Resize", cache)
+    if expected := []int{1, 2}; !slices.Equal(evicted, expected) {
+        t.Errorf("OnEvict\nresult: %v\nexpected: %v", evicted, expected)
+    }
+    cache.Put(6, 6)
+    if expected := []int{1, 2, 4}; !slices.Equal(evicted, expected) {
+        t.Errorf("OnEvict\nresult: %v\nexpected: %v", evicted, expected)
+    }
+}
+
+func TestNew_Panic(t *testing.T) {
+    defer func() {
+        if recover() == nil {
+            t.Errorf("New\nexpected panic for capacity 0")
+        }
+    }()
+    New[int, int](0)
+}
+
+func BenchmarkPut(b *testing.B) {
+    cache := New[int, int](1000)
+    for i := 0; i < b.N; i++ {
+        cache.Put(i%2000, i)
+    }
+}
@@ -0,0 +1,108 @@
+package lru
+
+import (
+    "iter"
+    "sync"
+)
+
+// Cache safe for concurrent use, guarded by a mutex. Get also takes the
+// exclusive lock, as it changes the order of entries. Use NewSync to create
+// one.
+type SyncCache[K comparable, V any] struct {
+    mutex sync.Mutex
+    cache *Cache[K, V]
+}
+
+// Returns a new empty thread-safe cache holding at most capacity entries.
+// Panics if capacity is not positive.
+func NewSync[K comparable, V any](capacity int) *SyncCache[K, V] {
+    return &SyncCache[K, V]{cache: New[K, V](capacity)}
+}
+
+// Returns an iterator over a snapshot of entries of the cache, from most to
+// least recently used. The snapshot is taken when iteration starts.
+func (cache *SyncCache[K, V]) All() iter.Seq2[K, V] {
+    return func(yield func(K, V) bool) {
+        cache.mutex.Lock()
+        var entries []entry[K, V]
+        for key, value := range cache.cache.All() {
+            entries = append(entries, entry[K, V]{key, value})
+        }
+        cache.mutex.Unlock()
+
+        for _, entry := range entries {
+            if !yield(entry.key, entry.value) {
+                return
+            }
+        }
+    }
+}
+
+// Returns maximum number of entries of the cache.
+func (cache *SyncCache[K, V]) Cap() int {
+    cache.mutex.Lock()
+    defer cache.mutex.Unlock()
+    return cache.cache.Cap()
+}
+
+// Same as Cache.Get.
+func (cache *SyncCache[K, V]) Get(key K) (V, bool) {
+    cache.mutex.Lock()
+    defer cache.mutex.Unlock()
+    return cache.cache.Get(key)
+}
+
+// Returns an iterator over a snapshot of keys of the cache, from most to least
+// recently used.
+func (cache *SyncCache[K, V]) Keys() iter.Seq[K] {
+    return func(yield func(K) bool) {
+        for key := range cache.All() {
+            if !yield(key) {
+                return
+            }
+        }
+    }
+}
+
+// Returns number of entries of the cache.
+func (cache *SyncCache[K, V]) Len() int {
+    cache.mutex.Lock()
+    defer cache.mutex.Unlock()
+    return cache.cache.Len()
+}
+
+// Same as Cache.OnEvict. fun is called while holding the lock, so it must not
+// call methods of the cache.
+func (cache *SyncCache[K, V]) OnEvict(fun func(key K, value V)) {
+    cache.mutex.Lock()
+    defer cache.mutex.Unlock()
+    cache.cache.OnEvict(fun)
+}
+
+// Same as Cache.Peek.
+func (cache *SyncCache[K, V]) Peek(key K) (V, bool) {
+    cache.mutex.Lock()
+    defer cache.mutex.Unlock()
+    return cache.cache.Peek(key)
+}
+
+// Same as Cache.Put.
+func (cache *SyncCache[K, V]) Put(key K, value V) bool {
+    cache.mutex.Lock()
+    defer cache.mutex.Unlock()
+    return cache.cache.Put(key, value)
+}
+
+// Same as Cache.Remove.
+func (cache *SyncCache[K, V]) Remove(key K) bool {
+    cache.mutex.Lock()
+    defer cache.mutex.Unlock()
+    return cache.cache.Remove(key)
+}
+
+// Same as Cache.Resize.
+func (cache *SyncCache[K, V]) Resize(capacity int) int {
+    cache.mutex.Lock()
+    defer cache.mutex.Unlock()
+    return cache.cache.Resize(capacity)
+}
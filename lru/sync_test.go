@@ -0,0 +1,58 @@
+package lru
+
+import (
+    "sync"
+    "testing"
+)
+
+func TestSyncCache(t *testing.T) {
+    cache := NewSync[int, int](100)
+    var evictions sync.Map
+    cache.OnEvict(func(key, value int) {
+        evictions.Store(key, value)
+    })
+
+    var wg sync.WaitGroup
+    for w := 0; w < 8; w++ {
+        wg.Add(1)
+        go func(w int) {
+            defer wg.Done()
+            for i := 0; i < 1000; i++ {
+                key := w*1000 + i
+                cache.Put(key, key)
+                cache.Get(key - 1)
+                cache.Peek(key - 2)
+                if i%10 == 0 {
+                    cache.Remove(key)
+                }
+                if i%100 == 0 {
+                    for range cache.All() {
+                    }
+                }
+            }
+        }(w)
+    }
+    wg.Wait()
+
+    if result := cache.Len(); result != 100 {
+        t.Errorf("Len\nresult: %v\nexpected: %v", result, 100)
+    }
+    count := 0
+    for key, value := range cache.All() {
+        if key != value {
+            t.Errorf("All\nresult: %v\nexpected: %v", value, key)
+        }
+        count++
+    }
+    if count != 100 {
+        t.Errorf("All\nresult: %v\nexpected: %v", count, 100)
+    }
+    if result := cache.Resize(10); result != 90 || cache.Cap() != 10 {
+        t.Errorf("Resize\nresult: %v\nexpected: %v", result, 90)
+    }
+    for key := range cache.Keys() {
+        if _, ok := evictions.Load(key); ok {
+            t.Errorf("OnEvict\nkey %v is evicted but still in cache", key)
+        }
+    }
+}
@@ -19,3 +19,40 @@ func (node Node[T]) String() string {
     }
     return fmt.Sprintf("%v", node.Data)
 }
+
+// Implements fmt.Formatter. Verbs %v and %s format the node same as String
+// method, %+v also prints the pointer to next node, and %#v prints the node in
+// Go syntax. Other verbs, flags, width and precision are applied to node data.
+func (node Node[T]) Format(state fmt.State, verb rune) {
+    var data any = node.Data
+    _, isString := data.(string)
+    switch {
+    case (verb == 'v' || verb == 's') && state.Flag('#'):
+        fmt.Fprintf(state, "%T{Data:%#v, Next:%s}", node, node.Data, goPointer(node.Next))
+    case (verb == 'v' || verb == 's') && state.Flag('+'):
+        fmt.Fprintf(state, "{Data:%s Next:%s}", node.String(), pointer(node.Next))
+    case (verb == 'v' || verb == 's') && isString:
+        fmt.Fprintf(state, fmt.FormatString(state, 'q'), data)
+    case verb == 's':
+        fmt.Fprintf(state, fmt.FormatString(state, 'v'), data)
+    default:
+        fmt.Fprintf(state, fmt.FormatString(state, verb), data)
+    }
+}
+
+// Returns the address of node, or <nil>. The node itself is not formatted, as
+// it would print the rest of the list.
+func pointer[N any](node *N) string {
+    if node == nil {
+        return "<nil>"
+    }
+    return fmt.Sprintf("%p", node)
+}
+
+// Returns the address of node in Go syntax, such as (*node.Node[int])(nil).
+func goPointer[N any](node *N) string {
+    if node == nil {
+        return fmt.Sprintf("(%T)(nil)", node)
+    }
+    return fmt.Sprintf("(%T)(%p)", node, node)
+}
@@ -20,3 +20,26 @@ func (node Node2[T]) String() string {
     }
     return fmt.Sprintf("%v", node.Data)
 }
+
+// Implements fmt.Formatter. Verbs %v and %s format the node same as String
+// method, %+v also prints the pointers to previous and next nodes, and %#v
+// prints the node in Go syntax. Other verbs, flags, width and precision are
+// applied to node data.
+func (node Node2[T]) Format(state fmt.State, verb rune) {
+    var data any = node.Data
+    _, isString := data.(string)
+    switch {
+    case (verb == 'v' || verb == 's') && state.Flag('#'):
+        fmt.Fprintf(state, "%T{Prev:%s, Data:%#v, Next:%s}",
+            node, goPointer(node.Prev), node.Data, goPointer(node.Next))
+    case (verb == 'v' || verb == 's') && state.Flag('+'):
+        fmt.Fprintf(state, "{Prev:%s Data:%s Next:%s}",
+            pointer(node.Prev), node.String(), pointer(node.Next))
+    case (verb == 'v' || verb == 's') && isString:
+        fmt.Fprintf(state, fmt.FormatString(state, 'q'), data)
+    case verb == 's':
+        fmt.Fprintf(state, fmt.FormatString(state, 'v'), data)
+    default:
+        fmt.Fprintf(state, fmt.FormatString(state, verb), data)
+    }
+}
@@ -1,6 +1,7 @@
 package node
 
 import (
+    "fmt"
     "testing"
 )
 
@@ -19,3 +20,26 @@ func TestNode2String_String(t *testing.T) {
         t.Errorf("String\nresult: %v\nexpected: %v", result, expected)
     }
 }
+
+func TestNode2Format(t *testing.T) {
+    last := &Node2[string]{Data: "b"}
+    tests := []struct {
+        format   string
+        node     any
+        expected string
+    }{
+        {"%v", Node2[string]{Data: "a"}, `"a"`},
+        {"%s", Node2[string]{Data: "a"}, `"a"`},
+        {"%5v", Node2[string]{Data: "a"}, `  "a"`},
+        {"%.2f", Node2[float64]{Data: 3.14159}, "3.14"},
+        {"%x", Node2[int]{Data: 255}, "ff"},
+        {"%+v", *last, `{Prev:<nil> Data:"b" Next:<nil>}`},
+        {"%#v", *last, `node.Node2[string]{Prev:(*node.Node2[string])(nil), Data:"b", Next:(*node.Node2[string])(nil)}`},
+        {"%+v", Node2[string]{Data: "a", Next: last}, fmt.Sprintf(`{Prev:<nil> Data:"a" Next:%p}`, last)},
+    }
+    for _, test := range tests {
+        if result := fmt.Sprintf(test.format, test.node); result != test.expected {
+            t.Errorf("Format %s\nresult: %v\nexpected: %v", test.format, result, test.expected)
+        }
+    }
+}
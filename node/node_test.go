@@ -1,6 +1,7 @@
 package node
 
 import (
+    "fmt"
     "testing"
 )
 
@@ -19,3 +20,26 @@ func TestNodeString_String(t *testing.T) {
         t.Errorf("String\nresult: %v\nexpected: %v", result, expected)
     }
 }
+
+func TestNodeFormat(t *testing.T) {
+    last := &Node[string]{Data: "b"}
+    tests := []struct {
+        format   string
+        node     any
+        expected string
+    }{
+        {"%v", Node[string]{Data: "a"}, `"a"`},
+        {"%s", Node[string]{Data: "a"}, `"a"`},
+        {"%5v", Node[string]{Data: "a"}, `  "a"`},
+        {"%.2f", Node[float64]{Data: 3.14159}, "3.14"},
+        {"%x", Node[int]{Data: 255}, "ff"},
+        {"%+v", *last, `{Data:"b" Next:<nil>}`},
+        {"%#v", *last, `node.Node[string]{Data:"b", Next:(*node.Node[string])(nil)}`},
+        {"%+v", Node[string]{Data: "a", Next: last}, fmt.Sprintf(`{Data:"a" Next:%p}`, last)},
+    }
+    for _, test := range tests {
+        if result := fmt.Sprintf(test.format, test.node); result != test.expected {
+            t.Errorf("Format %s\nresult: %v\nexpected: %v", test.format, result, test.expected)
+        }
+    }
+}
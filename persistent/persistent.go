@@ -0,0 +1,515 @@
+// Package persistent contains functions for persistent singly linked list in
+// Go, which is immutable and shares unchanged nodes between lists.
+package persistent
+
+import (
+    "errors"
+    "io"
+    "iter"
+    "strings"
+    "github.com/hiennguyen-neih/go-linkedlist/internal/listtext"
+    "github.com/hiennguyen-neih/go-linkedlist/golist"
+)
+
+/*
+ *******************************************************************************
+ * Define structs and interfaces
+ *******************************************************************************
+ */
+
+// Node in persistent list. Nodes are never modified once they are part of a
+// list, which is what makes sharing them safe.
+type cell[T any] struct {
+    data T
+    next *cell[T]    // Pointer to next node of the list.
+}
+
+// Struct of Go persistent singly linked list. The zero value is an empty list.
+//
+// Like golist.GoList, functions return new lists and never modify their input,
+// but instead of copying, the result shares the unchanged end of the input
+// list. Prepending, Tail, NthTail, DropWhile and the second list of Split are
+// O(1) or O(k) for k skipped nodes. Nodes are not exported, so callers can not
+// break the sharing by modifying them.
+type List[T any] struct {
+    head *cell[T]    // First node of the list.
+    size int         // Number of nodes in the list.
+}
+
+// Errors returned by the Try functions of the package.
+var (
+    ErrEmptyList       = errors.New("persistent: list is empty")
+    ErrIndexOutOfRange = errors.New("persistent: index out of range")
+)
+
+// Text format of the list, such as ["a"->"b"], written by WriteTo. It is the
+// format of golist.GoList.
+var textFormat = listtext.Format{Sep: "->"}
+
+/*
+ *******************************************************************************
+ * Exported functions
+ *******************************************************************************
+ */
+
+// Returns a new persistent list containing all input elements.
+func New[T any](elems ...T) List[T] {
+    return FromSlice(elems)
+}
+
+// Returns a new persistent list containing all elements of slice.
+func FromSlice[T any](slice []T) List[T] {
+    var result List[T]
+    for i := len(slice) - 1; i >= 0; i-- {
+        result = cons(slice[i], result)
+    }
+    return result
+}
+
+// Returns a new persistent list containing all values yielded by seq.
+func FromSeq[T any](seq iter.Seq[T]) List[T] {
+    var result nodeBuilder[T]
+    for elem := range seq {
+        result.add(elem)
+    }
+    return result.build(List[T]{})
+}
+
+// Returns a new persistent list containing node data of list.
+func FromList[T any](list golist.GoList[T]) List[T] {
+    return FromSeq(list.All())
+}
+
+// Returns a slice containing all elements of list.
+func ToSlice[T any](list List[T]) []T {
+    result := make([]T, 0, list.size)
+    for elem := range list.All() {
+        result = append(result, elem)
+    }
+    return result
+}
+
+// Returns a golist.GoList containing all elements of list.
+func ToList[T any](list List[T]) golist.GoList[T] {
+    return golist.FromSeq(list.All())
+}
+
+// Returns a list that input elements are appended to the end of list. The
+// nodes of list are copied, so it is O(n).
+func Append[T any](list List[T], elems ...T) List[T] {
+    return Concat(list, New(elems...))
+}
+
+// Returns a list that input elements are prepended to list, sharing all nodes
+// of list. O(k) for k input elements.
+func AppendHead[T any](list List[T], elems ...T) List[T] {
+    for i := len(elems) - 1; i >= 0; i-- {
+        list = cons(elems[i], list)
+    }
+    return list
+}
+
+// Returns a list that is concatenated of all input lists. Nodes of all but the
+// last list are copied, the last list is shared.
+func Concat[T any](lists ...List[T]) List[T] {
+    if len(lists) == 0 {
+        return List[T]{}
+    }
+    var result nodeBuilder[T]
+    for _, list := range lists[:len(lists)-1] {
+        for elem := range list.All() {
+            result.add(elem)
+        }
+    }
+    return result.build(lists[len(lists)-1])
+}
+
+// Deletes element at the specific index of list. If index is out of bound,
+// the original list is returned. Negative index indicate an offset from the
+// end of list. See TryDeleteAt for a variant returning an error.
+func DeleteAt[T any](list List[T], index int) List[T] {
+    result, err := TryDeleteAt(list, index)
+    if err != nil {
+        return list
+    }
+    return result
+}
+
+// Drops elements from list while fun returns true. The result shares the
+// remaining nodes of list.
+func DropWhile[T any](list List[T], fun func(T) bool) List[T] {
+    for list.head != nil && fun(list.head.data) {
+        list = tail(list)
+    }
+    return list
+}
+
+// Returns true if both lists have the same length and equal elements in the
+// same order, compared with ==. Shared nodes are not compared.
+func Equal[T comparable](list1, list2 List[T]) bool {
+    if list1.size != list2.size {
+        return false
+    }
+    node1, node2 := list1.head, list2.head
+    for node1 != node2 {
+        if node1.data != node2.data {
+            return false
+        }
+        node1, node2 = node1.next, node2.next
+    }
+    return true
+}
+
+// Returns a list of all elements in list that fun returns true. The longest
+// end of list in which fun returns true for all elements is shared.
+func Filter[T any](list List[T], fun func(T) bool) List[T] {
+    var result nodeBuilder[T]
+    rest := list   // end of list kept as a whole so far
+    for node := list.head; node != nil; node = node.next {
+        if !fun(node.data) {
+            for ; rest.head != node; rest = tail(rest) {
+                result.add(rest.head.data)
+            }
+            rest = tail(rest)
+        }
+    }
+    return result.build(rest)
+}
+
+// Calls fun(elem, acc) on successive elements of list from left to right,
+// starting with acc0, and returns the final value of the accumulator.
+func Foldl[T1, T2 any](list List[T1], acc0 T2, fun func(T1, T2) T2) T2 {
+    for elem := range list.All() {
+        acc0 = fun(elem, acc0)
+    }
+    return acc0
+}
+
+// Calls fun(elem, acc) on successive elements of list from right to left,
+// starting with acc0, and returns the final value of the accumulator.
+func Foldr[T1, T2 any](list List[T1], acc0 T2, fun func(T1, T2) T2) T2 {
+    return Foldl(Reverse(list), acc0, fun)
+}
+
+// Returns the first element of list and true, or zero value and false if list
+// is empty. O(1).
+func Head[T any](list List[T]) (T, bool) {
+    if list.head == nil {
+        var zero T
+        return zero, false
+    }
+    return list.head.data, true
+}
+
+// Returns a list with val is inserted at specific index. Negative index
+// indicate an offset from the end of list. Panics if index is out of bound,
+// see TryInsertAt for a variant returning an error.
+func InsertAt[T any](list List[T], index int, val T) List[T] {
+    result, err := TryInsertAt(list, index, val)
+    if err != nil {
+        panic("InsertAt, index is out of bound!")
+    }
+    return result
+}
+
+// Returns the number of elements in list. O(1).
+func Len[T any](list List[T]) int {
+    return list.size
+}
+
+// Calls fun(elem) to every elements in list and returns a list contains
+// returned values of that fun.
+func Map[T1, T2 any](list List[T1], fun func(T1) T2) List[T2] {
+    var result nodeBuilder[T2]
+    for elem := range list.All() {
+        result.add(fun(elem))
+    }
+    return result.build(List[T2]{})
+}
+
+// Returns element of list at specific index. Negative index indicate an
+// offset from the end of list. Panics if index is out of bound, see TryNth for
+// a variant returning an error.
+func Nth[T any](list List[T], index int) T {
+    elem, err := TryNth(list, index)
+    if err != nil {
+        panic("Nth, index is out of bound!")
+    }
+    return elem
+}
+
+// Returns sublist from element at specific index, sharing its nodes with list.
+// Negative index indicate an offset from the end of list. Panics if index is
+// out of bound, see TryNthTail for a variant returning an error.
+func NthTail[T any](list List[T], index int) List[T] {
+    result, err := TryNthTail(list, index)
+    if err != nil {
+        panic("NthTail, index is out of bound!")
+    }
+    return result
+}
+
+// Returns a list that element at specific index is replaced with val. If
+// index is out of bound, the original list is returned. Negative index
+// indicate an offset from the end of list.
+func ReplaceAt[T any](list List[T], index int, val T) List[T] {
+    return UpdateAt(list, index, func(T) T { return val })
+}
+
+// Returns a list containing the elements of input list in reverse order.
+func Reverse[T any](list List[T]) List[T] {
+    var result List[T]
+    for elem := range list.All() {
+        result = cons(elem, result)
+    }
+    return result
+}
+
+// Split input list into list1 and list2, list1 contains n first elements and
+// list2 contains the remaining elements. list1 is a copy, list2 shares its
+// nodes with list. Negative n indicate an offset from the end of list. Panics
+// if n is out of bound, see TrySplit for a variant returning an error.
+func Split[T any](list List[T], n int) (List[T], List[T]) {
+    list1, list2, err := TrySplit(list, n)
+    if err != nil {
+        panic("Split, n is out of bound!")
+    }
+    return list1, list2
+}
+
+// Split input list into list1 and list2, where list1 behave as
+// TakeWhile(fun, list) and list2 behave as DropWhile(fun, list).
+func SplitWith[T any](list List[T], fun func(T) bool) (List[T], List[T]) {
+    var list1 nodeBuilder[T]
+    for list.head != nil && fun(list.head.data) {
+        list1.add(list.head.data)
+        list = tail(list)
+    }
+    return list1.build(List[T]{}), list
+}
+
+// Returns list without its first element and true, or an empty list and false
+// if list is empty. The result shares all nodes with list. O(1).
+func Tail[T any](list List[T]) (List[T], bool) {
+    if list.head == nil {
+        return list, false
+    }
+    return tail(list), true
+}
+
+// Takes elements of list while fun returns true, returning the longest prefix
+// in which all elements satisfy the predicate.
+func TakeWhile[T any](list List[T], fun func(T) bool) List[T] {
+    result, _ := SplitWith(list, fun)
+    return result
+}
+
+// Deletes element at the specific index of list. The nodes after index are
+// shared. Negative index indicate an offset from the end of list. Returns
+// ErrEmptyList if list is empty and ErrIndexOutOfRange if index is out of
+// bound.
+func TryDeleteAt[T any](list List[T], index int) (List[T], error) {
+    index, err := elemIndex(index, list.size)
+    if err != nil {
+        return List[T]{}, err
+    }
+    prefix, rest := splitAt(list, index)
+    return prefix.build(tail(rest)), nil
+}
+
+// Returns a list with val is inserted at specific index. The nodes after index
+// are shared. Negative index indicate an offset from the end of list. Returns
+// ErrIndexOutOfRange if index is out of bound.
+func TryInsertAt[T any](list List[T], index int, val T) (List[T], error) {
+    if index < 0 {
+        index = list.size + index // same as len - abs(index)
+    }
+    if index < 0 || index > list.size {
+        return List[T]{}, ErrIndexOutOfRange
+    }
+
+    prefix, rest := splitAt(list, index)
+    return prefix.build(cons(val, rest)), nil
+}
+
+// Returns element of list at specific index. Negative index indicate an
+// offset from the end of list. Returns ErrEmptyList if list is empty and
+// ErrIndexOutOfRange if index is out of bound.
+func TryNth[T any](list List[T], index int) (T, error) {
+    rest, err := TryNthTail(list, index)
+    if err != nil {
+        var zero T
+        return zero, err
+    }
+    return rest.head.data, nil
+}
+
+// Returns sublist from element at specific index, sharing its nodes with list.
+// O(index). Negative index indicate an offset from the end of list. Returns
+// ErrEmptyList if list is empty and ErrIndexOutOfRange if index is out of
+// bound.
+func TryNthTail[T any](list List[T], index int) (List[T], error) {
+    index, err := elemIndex(index, list.size)
+    if err != nil {
+        return List[T]{}, err
+    }
+    for ; index > 0; index-- {
+        list = tail(list)
+    }
+    return list, nil
+}
+
+// Split input list into list1 and list2, list1 contains n first elements and
+// list2 contains the remaining elements, sharing its nodes with list. Negative
+// n indicate an offset from the end of list. Returns ErrEmptyList if list is
+// empty and ErrIndexOutOfRange if n is out of bound.
+func TrySplit[T any](list List[T], n int) (List[T], List[T], error) {
+    n, err := elemIndex(n, list.size)
+    if err != nil {
+        return List[T]{}, List[T]{}, err
+    }
+
+    list1, list2 := splitAt(list, n)
+    return list1.build(List[T]{}), list2, nil
+}
+
+// Returns a list that element at specific index is updated with returns value
+// of fun. The nodes after index are shared. Negative index indicate an offset
+// from the end of list. Returns ErrEmptyList if list is empty and
+// ErrIndexOutOfRange if index is out of bound.
+func TryUpdateAt[T any](list List[T], index int, fun func(T) T) (List[T], error) {
+    index, err := elemIndex(index, list.size)
+    if err != nil {
+        return List[T]{}, err
+    }
+    prefix, rest := splitAt(list, index)
+    return prefix.build(cons(fun(rest.head.data), tail(rest))), nil
+}
+
+// Returns a list that element at specific index is updated with returns value
+// of fun. If index is out of bound, the original list is returned. Negative
+// index indicate an offset from the end of list. See TryUpdateAt for a variant
+// returning an error.
+func UpdateAt[T any](list List[T], index int, fun func(T) T) List[T] {
+    result, err := TryUpdateAt(list, index, fun)
+    if err != nil {
+        return list
+    }
+    return result
+}
+
+/*
+ *******************************************************************************
+ * Exported methods
+ *******************************************************************************
+ */
+
+// Returns an iterator over elements of list, from first to last.
+func (list List[T]) All() iter.Seq[T] {
+    return func(yield func(T) bool) {
+        for node := list.head; node != nil; node = node.next {
+            if !yield(node.data) {
+                return
+            }
+        }
+    }
+}
+
+// Returns an iterator over index and element of list, from first to last.
+func (list List[T]) Enumerate() iter.Seq2[int, T] {
+    return func(yield func(int, T) bool) {
+        i := 0
+        for node := list.head; node != nil; node = node.next {
+            if !yield(i, node.data) {
+                return
+            }
+            i++
+        }
+    }
+}
+
+// Returns a string representing the persistent list, in the same format as
+// golist.GoList.
+func (list List[T]) String() string {
+    var builder strings.Builder
+    list.WriteTo(&builder)
+    return builder.String()
+}
+
+// Writes the same text as String method into w, node by node, without
+// building the whole string in memory. Returns the number of bytes written.
+// Implements io.WriterTo.
+func (list List[T]) WriteTo(w io.Writer) (int64, error) {
+    return listtext.Write(textFormat, w, list.All())
+}
+
+/*
+ *******************************************************************************
+ * Internal functions and methods
+ *******************************************************************************
+ */
+
+// Builder of the new nodes at the start of a list. The nodes are linked in
+// place until build publishes them, after which they are never modified.
+type nodeBuilder[T any] struct {
+    head *cell[T]
+    last *cell[T]
+    size int
+}
+
+// Do add value to the end of the new nodes.
+func (builder *nodeBuilder[T]) add(value T) {
+    node := &cell[T]{data: value}
+    if builder.last == nil {
+        builder.head = node
+    } else {
+        builder.last.next = node
+    }
+    builder.last = node
+    builder.size++
+}
+
+// Returns the list of the new nodes followed by the shared nodes of rest.
+func (builder *nodeBuilder[T]) build(rest List[T]) List[T] {
+    if builder.last == nil {
+        return rest
+    }
+    builder.last.next = rest.head
+    return List[T]{head: builder.head, size: builder.size + rest.size}
+}
+
+// Returns list with value prepended. O(1).
+func cons[T any](value T, list List[T]) List[T] {
+    return List[T]{head: &cell[T]{data: value, next: list.head}, size: list.size + 1}
+}
+
+// Returns a builder holding a copy of the n first nodes of list, and the rest
+// of list. n must be in range.
+func splitAt[T any](list List[T], n int) (nodeBuilder[T], List[T]) {
+    var prefix nodeBuilder[T]
+    for ; n > 0; n-- {
+        prefix.add(list.head.data)
+        list = tail(list)
+    }
+    return prefix, list
+}
+
+// Returns list without its first node. list must not be empty.
+func tail[T any](list List[T]) List[T] {
+    return List[T]{head: list.head.next, size: list.size - 1}
+}
+
+// Returns index converted into a non-negative index of a list of length len,
+// or ErrEmptyList or ErrIndexOutOfRange.
+func elemIndex(index, len int) (int, error) {
+    if len == 0 {
+        return 0, ErrEmptyList
+    }
+    if index < 0 {
+        index = len + index // same as len - abs(index)
+    }
+    if index < 0 || index >= len {
+        return 0, ErrIndexOutOfRange
+    }
+    return index, nil
+}
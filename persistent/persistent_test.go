@@ -0,0 +1,207 @@
+package persistent
+
+import (
+    "errors"
+    "slices"
+    "testing"
+    "github.com/hiennguyen-neih/go-linkedlist/golist"
+)
+
+// Returns true if list1 ends with the very same nodes as list2.
+func shares[T any](list1, list2 List[T]) bool {
+    if list1.size < list2.size {
+        return false
+    }
+    node := list1.head
+    for i := 0; i < list1.size-list2.size; i++ {
+        node = node.next
+    }
+    return node == list2.head
+}
+
+// Checks that size of list matches its nodes.
+func checkInvariants[T any](t *testing.T, name string, list List[T]) {
+    t.Helper()
+    size := 0
+    for node := list.head; node != nil; node = node.next {
+        size++
+    }
+    if size != list.size {
+        t.Errorf("%s\nsize: %v\nexpected: %v", name, list.size, size)
+    }
+}
+
+func TestConversions(t *testing.T) {
+    list := New(1, 2, 3)
+    if result, expected := ToSlice(list), []int{1, 2, 3}; !slices.Equal(result, expected) {
+        t.Errorf("ToSlice\nresult: %v\nexpected: %v", result, expected)
+    }
+    if result := FromList(ToList(list)); !Equal(result, list) {
+        t.Errorf("FromList\nresult: %v\nexpected: %v", result, list)
+    }
+    if result, expected := FromSeq(golist.New("a", "b").All()).String(), `["a"->"b"]`; result != expected {
+        t.Errorf("String\nresult: %v\nexpected: %v", result, expected)
+    }
+    if result, expected := New[int]().String(), "[]"; result != expected {
+        t.Errorf("String\nresult: %v\nexpected: %v", result, expected)
+    }
+    if result, expected := New("é", "b").String(), golist.New("é", "b").String(); result != expected {
+        t.Errorf("String\nresult: %v\nexpected: %v", result, expected)
+    }
+}
+
+func TestAppendHead(t *testing.T) {
+    list := New(3, 4)
+    result := AppendHead(list, 1, 2)
+    checkInvariants(t, "AppendHead", result)
+    if !Equal(result, New(1, 2, 3, 4)) || !shares(result, list) {
+        t.Errorf("AppendHead\nresult: %v\nexpected: %v sharing %v", result, New(1, 2, 3, 4), list)
+    }
+    if !Equal(list, New(3, 4)) {
+        t.Errorf("AppendHead\ninput list is modified: %v", list)
+    }
+}
+
+func TestHeadTail(t *testing.T) {
+    list := New(1, 2, 3)
+    if head, ok := Head(list); !ok || head != 1 {
+        t.Errorf("Head\nresult: %v, %v\nexpected: 1, true", head, ok)
+    }
+    rest, ok := Tail(list)
+    if !ok || !Equal(rest, New(2, 3)) || !shares(list, rest) {
+        t.Errorf("Tail\nresult: %v, %v\nexpected: [2->3], true", rest, ok)
+    }
+    if _, ok := Head(New[int]()); ok {
+        t.Errorf("Head\nresult: true\nexpected: false")
+    }
+    if _, ok := Tail(New[int]()); ok {
+        t.Errorf("Tail\nresult: true\nexpected: false")
+    }
+}
+
+func TestNthTail(t *testing.T) {
+    list := New(0, 1, 2, 3, 4)
+    result := NthTail(list, 3)
+    if !Equal(result, New(3, 4)) || !shares(list, result) {
+        t.Errorf("NthTail\nresult: %v\nexpected: %v", result, New(3, 4))
+    }
+    if result := Nth(list, -1); result != 4 {
+        t.Errorf("Nth\nresult: %v\nexpected: %v", result, 4)
+    }
+    if _, err := TryNthTail(list, 5); !errors.Is(err, ErrIndexOutOfRange) {
+        t.Errorf("TryNthTail\nresult: %v\nexpected: %v", err, ErrIndexOutOfRange)
+    }
+    if _, err := TryNth(New[int](), 0); !errors.Is(err, ErrEmptyList) {
+        t.Errorf("TryNth\nresult: %v\nexpected: %v", err, ErrEmptyList)
+    }
+}
+
+func TestDropWhile(t *testing.T) {
+    list := New(1, 2, 3, 1)
+    result := DropWhile(list, func(x int) bool { return x < 3 })
+    if !Equal(result, New(3, 1)) || !shares(list, result) {
+        t.Errorf("DropWhile\nresult: %v\nexpected: %v", result, New(3, 1))
+    }
+    if result := TakeWhile(list, func(x int) bool { return x < 3 }); !Equal(result, New(1, 2)) {
+        t.Errorf("TakeWhile\nresult: %v\nexpected: %v", result, New(1, 2))
+    }
+}
+
+func TestSplit(t *testing.T) {
+    list := New(1, 2, 3, 4, 5)
+    list1, list2 := Split(list, 2)
+    checkInvariants(t, "Split", list1)
+    if !Equal(list1, New(1, 2)) || !Equal(list2, New(3, 4, 5)) || !shares(list, list2) {
+        t.Errorf("Split\nresult: %v, %v\nexpected: [1->2], [3->4->5]", list1, list2)
+    }
+    if !Equal(list, New(1, 2, 3, 4, 5)) {
+        t.Errorf("Split\ninput list is modified: %v", list)
+    }
+    if _, _, err := TrySplit(list, 5); !errors.Is(err, ErrIndexOutOfRange) {
+        t.Errorf("TrySplit\nresult: %v\nexpected: %v", err, ErrIndexOutOfRange)
+    }
+    list1, list2 = SplitWith(list, func(x int) bool { return x != 4 })
+    if !Equal(list1, New(1, 2, 3)) || !shares(list, list2) {
+        t.Errorf("SplitWith\nresult: %v, %v\nexpected: [1->2->3], [4->5]", list1, list2)
+    }
+}
+
+func TestInsertDeleteUpdateAt(t *testing.T) {
+    list := New(1, 2, 3, 4)
+    inserted := InsertAt(list, 1, 9)
+    checkInvariants(t, "InsertAt", inserted)
+    if !Equal(inserted, New(1, 9, 2, 3, 4)) || !shares(inserted, NthTail(list, 1)) {
+        t.Errorf("InsertAt\nresult: %v\nexpected: %v", inserted, New(1, 9, 2, 3, 4))
+    }
+    if result := InsertAt(list, 4, 5); !Equal(result, New(1, 2, 3, 4, 5)) {
+        t.Errorf("InsertAt\nresult: %v\nexpected: %v", result, New(1, 2, 3, 4, 5))
+    }
+    deleted := DeleteAt(list, 1)
+    checkInvariants(t, "DeleteAt", deleted)
+    if !Equal(deleted, New(1, 3, 4)) || !shares(deleted, NthTail(list, 2)) {
+        t.Errorf("DeleteAt\nresult: %v\nexpected: %v", deleted, New(1, 3, 4))
+    }
+    if result := ReplaceAt(list, -1, 0); !Equal(result, New(1, 2, 3, 0)) {
+        t.Errorf("ReplaceAt\nresult: %v\nexpected: %v", result, New(1, 2, 3, 0))
+    }
+    if !Equal(list, New(1, 2, 3, 4)) {
+        t.Errorf("InsertAt\ninput list is modified: %v", list)
+    }
+    if _, err := TryInsertAt(list, 5, 0); !errors.Is(err, ErrIndexOutOfRange) {
+        t.Errorf("TryInsertAt\nresult: %v\nexpected: %v", err, ErrIndexOutOfRange)
+    }
+}
+
+func TestConcat(t *testing.T) {
+    list1, list2 := New(1, 2), New(3, 4)
+    result := Concat(list1, New[int](), list2)
+    checkInvariants(t, "Concat", result)
+    if !Equal(result, New(1, 2, 3, 4)) || !shares(result, list2) {
+        t.Errorf("Concat\nresult: %v\nexpected: %v", result, New(1, 2, 3, 4))
+    }
+    if result := Append(list1, 3); !Equal(result, New(1, 2, 3)) || !Equal(list1, New(1, 2)) {
+        t.Errorf("Append\nresult: %v\nexpected: %v", result, New(1, 2, 3))
+    }
+}
+
+func TestFunctional(t *testing.T) {
+    list := New(1, 2, 3, 4, 5, 6)
+    result := Filter(list, func(x int) bool { return x != 2 })
+    checkInvariants(t, "Filter", result)
+    if !Equal(result, New(1, 3, 4, 5, 6)) || !shares(result, NthTail(list, 2)) {
+        t.Errorf("Filter\nresult: %v\nexpected: %v", result, New(1, 3, 4, 5, 6))
+    }
+    if result := Filter(list, func(x int) bool { return x%2 == 0 }); !Equal(result, New(2, 4, 6)) {
+        t.Errorf("Filter\nresult: %v\nexpected: %v", result, New(2, 4, 6))
+    }
+    if result := Map(list, func(x int) int { return x * x }); !Equal(result, New(1, 4, 9, 16, 25, 36)) {
+        t.Errorf("Map\nresult: %v\nexpected: %v", result, New(1, 4, 9, 16, 25, 36))
+    }
+    if result := Foldr(list, 0, func(x, acc int) int { return acc*10 + x }); result != 654321 {
+        t.Errorf("Foldr\nresult: %v\nexpected: %v", result, 654321)
+    }
+    if result := Reverse(list); !Equal(result, New(6, 5, 4, 3, 2, 1)) {
+        t.Errorf("Reverse\nresult: %v\nexpected: %v", result, New(6, 5, 4, 3, 2, 1))
+    }
+    for i, elem := range list.Enumerate() {
+        if elem != i+1 {
+            t.Errorf("Enumerate\nresult: %v\nexpected: %v", elem, i+1)
+        }
+    }
+}
+
+func BenchmarkAppendHead(b *testing.B) {
+    list := FromSeq(golist.Seq(1, 10000, 1).All())
+    b.ResetTimer()
+    for i := 0; i < b.N; i++ {
+        AppendHead(list, 0)
+    }
+}
+
+func BenchmarkAppendHead_GoList(b *testing.B) {
+    list := golist.Seq(1, 10000, 1)
+    b.ResetTimer()
+    for i := 0; i < b.N; i++ {
+        golist.AppendHead(list, 0)
+    }
+}
@@ -0,0 +1,85 @@
+package skiplist
+
+import (
+    "cmp"
+    "iter"
+    "github.com/hiennguyen-neih/go-linkedlist/constraints"
+)
+
+// Set which elements are kept in ascending order, on a skip list. Use
+// NewSortedSet or NewSortedSetFunc to create one, the zero value is not usable.
+type SortedSet[T any] struct {
+    sortedMap *SortedMap[T, struct{}]
+}
+
+// Returns a new set containing all input elements. This function only works
+// with constraint Ordered elements.
+func NewSortedSet[T constraints.Ordered](elems ...T) *SortedSet[T] {
+    return NewSortedSetFunc(cmp.Compare[T], elems...)
+}
+
+// Returns a new set containing all input elements, ordered by cmp. cmp(a, b)
+// should return a negative number when a < b, a positive number when a > b
+// and zero when a == b.
+func NewSortedSetFunc[T any](cmp func(a, b T) int, elems ...T) *SortedSet[T] {
+    set := &SortedSet[T]{sortedMap: NewSortedMapFunc[T, struct{}](cmp)}
+    for _, elem := range elems {
+        set.Insert(elem)
+    }
+    return set
+}
+
+// Returns an iterator over elements of the set, in ascending order.
+func (set *SortedSet[T]) All() iter.Seq[T] {
+    return set.sortedMap.Keys()
+}
+
+// Returns the least element greater than or equal to value and true, or zero
+// value and false if there is no such element.
+func (set *SortedSet[T]) Ceiling(value T) (T, bool) {
+    elem, _, ok := set.sortedMap.Ceiling(value)
+    return elem, ok
+}
+
+// Returns true if value is an element of the set.
+func (set *SortedSet[T]) Contains(value T) bool {
+    return set.sortedMap.Contains(value)
+}
+
+// Removes value from the set. Returns true if value was in the set.
+func (set *SortedSet[T]) Delete(value T) bool {
+    return set.sortedMap.Delete(value)
+}
+
+// Returns the greatest element less than or equal to value and true, or zero
+// value and false if there is no such element.
+func (set *SortedSet[T]) Floor(value T) (T, bool) {
+    elem, _, ok := set.sortedMap.Floor(value)
+    return elem, ok
+}
+
+// Inserts value into the set. Returns true if value is new.
+func (set *SortedSet[T]) Insert(value T) bool {
+    return set.sortedMap.Insert(value, struct{}{})
+}
+
+// Returns number of elements of the set.
+func (set *SortedSet[T]) Len() int {
+    return set.sortedMap.Len()
+}
+
+// Returns an iterator over elements in range [from, to), in ascending order.
+func (set *SortedSet[T]) Range(from, to T) iter.Seq[T] {
+    return func(yield func(T) bool) {
+        for elem := range set.sortedMap.Range(from, to) {
+            if !yield(elem) {
+                return
+            }
+        }
+    }
+}
+
+// Resets the generator of node levels with seed, see SortedMap.Seed.
+func (set *SortedSet[T]) Seed(seed uint64) {
+    set.sortedMap.Seed(seed)
+}
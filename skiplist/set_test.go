@@ -0,0 +1,56 @@
+package skiplist
+
+import (
+    "slices"
+    "testing"
+)
+
+func TestSortedSet(t *testing.T) {
+    set := NewSortedSet(5, 1, 3, 1, 9)
+    if result, expected := slices.Collect(set.All()), []int{1, 3, 5, 9}; !slices.Equal(result, expected) {
+        t.Errorf("NewSortedSet\nresult: %v\nexpected: %v", result, expected)
+    }
+    if set.Insert(3) || !set.Insert(4) || set.Len() != 5 {
+        t.Errorf("Insert\nresult: %v\nexpected: %v", set.Len(), 5)
+    }
+    if !set.Contains(4) || set.Contains(2) {
+        t.Errorf("Contains\nresult: false\nexpected: true")
+    }
+    if value, ok := set.Floor(8); !ok || value != 5 {
+        t.Errorf("Floor\nresult: %v, %v\nexpected: 5, true", value, ok)
+    }
+    if value, ok := set.Ceiling(6); !ok || value != 9 {
+        t.Errorf("Ceiling\nresult: %v, %v\nexpected: 9, true", value, ok)
+    }
+    if result, expected := slices.Collect(set.Range(2, 9)), []int{3, 4, 5}; !slices.Equal(result, expected) {
+        t.Errorf("Range\nresult: %v\nexpected: %v", result, expected)
+    }
+    if !set.Delete(1) || set.Delete(1) {
+        t.Errorf("Delete\nresult: false\nexpected: true")
+    }
+}
+
+func TestSortedSet_IterateAfterChange(t *testing.T) {
+    set := NewSortedSet(5)
+    all, partial := set.All(), set.Range(0, 5)
+    set.Insert(1)
+    if result, expected := slices.Collect(all), []int{1, 5}; !slices.Equal(result, expected) {
+        t.Errorf("All\nresult: %v\nexpected: %v", result, expected)
+    }
+    if result, expected := slices.Collect(partial), []int{1}; !slices.Equal(result, expected) {
+        t.Errorf("Range\nresult: %v\nexpected: %v", result, expected)
+    }
+}
+
+func TestSortedSetFunc(t *testing.T) {
+    type person struct {
+        name string
+        age  int
+    }
+    byAge := func(a, b person) int { return a.age - b.age }
+    set := NewSortedSetFunc(byAge, person{"a", 30}, person{"b", 20})
+    set.Seed(7)
+    if value, ok := set.Floor(person{age: 25}); !ok || value.name != "b" {
+        t.Errorf("Floor\nresult: %v, %v\nexpected: b, true", value, ok)
+    }
+}
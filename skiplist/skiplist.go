@@ -0,0 +1,245 @@
+// Package skiplist contains a sorted map and a sorted set on skip list in Go,
+// with O(log n) expected time for lookups, insertions and deletions.
+package skiplist
+
+import (
+    "cmp"
+    "iter"
+    "math/rand/v2"
+    "github.com/hiennguyen-neih/go-linkedlist/constraints"
+)
+
+/*
+ *******************************************************************************
+ * Define structs and interfaces
+ *******************************************************************************
+ */
+
+// Maximum number of levels of a skip list, enough for 4^32 entries.
+const maxLevel = 32
+
+// Node in skip list. Level i of the list links the nodes through next[i].
+type skipNode[K, V any] struct {
+    key   K
+    value V
+    next  []*skipNode[K, V]    // Pointers to next node at each level.
+}
+
+// Map which keys are kept in ascending order, on a skip list. Use NewSortedMap
+// or NewSortedMapFunc to create one, the zero value is not usable.
+type SortedMap[K, V any] struct {
+    head   *skipNode[K, V]      // Sentinel node before the first entry.
+    level  int                  // Number of levels in use.
+    size   int                  // Number of entries in the map.
+    cmp    func(a, b K) int
+    random *rand.Rand           // Generator of node levels.
+}
+
+/*
+ *******************************************************************************
+ * Exported functions
+ *******************************************************************************
+ */
+
+// Returns a new empty map ordered by keys. This function only works with
+// constraint Ordered keys.
+func NewSortedMap[K constraints.Ordered, V any]() *SortedMap[K, V] {
+    return NewSortedMapFunc[K, V](cmp.Compare[K])
+}
+
+// Returns a new empty map ordered by cmp. cmp(a, b) should return a negative
+// number when a < b, a positive number when a > b and zero when a == b.
+func NewSortedMapFunc[K, V any](cmp func(a, b K) int) *SortedMap[K, V] {
+    return &SortedMap[K, V]{
+        head:   &skipNode[K, V]{next: make([]*skipNode[K, V], maxLevel)},
+        level:  1,
+        cmp:    cmp,
+        random: rand.New(rand.NewPCG(rand.Uint64(), rand.Uint64())),
+    }
+}
+
+/*
+ *******************************************************************************
+ * Exported methods
+ *******************************************************************************
+ */
+
+// Returns an iterator over entries of the map, in ascending order of keys.
+func (sortedMap *SortedMap[K, V]) All() iter.Seq2[K, V] {
+    return sortedMap.iterate(nil, nil)
+}
+
+// Returns entry with the least key greater than or equal to key and true, or
+// zero values and false if there is no such key.
+func (sortedMap *SortedMap[K, V]) Ceiling(key K) (K, V, bool) {
+    return entry(sortedMap.lowerBound(key))
+}
+
+// Returns true if the map contains key.
+func (sortedMap *SortedMap[K, V]) Contains(key K) bool {
+    _, ok := sortedMap.Get(key)
+    return ok
+}
+
+// Removes key from the map. Returns true if key was in the map.
+func (sortedMap *SortedMap[K, V]) Delete(key K) bool {
+    var update [maxLevel]*skipNode[K, V]
+    node := sortedMap.search(key, &update)
+    if node == nil || sortedMap.cmp(node.key, key) != 0 {
+        return false
+    }
+    for i := range node.next {
+        update[i].next[i] = node.next[i]
+    }
+    for sortedMap.level > 1 && sortedMap.head.next[sortedMap.level-1] == nil {
+        sortedMap.level--
+    }
+    sortedMap.size--
+    return true
+}
+
+// Returns entry with the greatest key less than or equal to key and true, or
+// zero values and false if there is no such key.
+func (sortedMap *SortedMap[K, V]) Floor(key K) (K, V, bool) {
+    node := sortedMap.head
+    for i := sortedMap.level - 1; i >= 0; i-- {
+        for node.next[i] != nil && sortedMap.cmp(node.next[i].key, key) <= 0 {
+            node = node.next[i]
+        }
+    }
+    if node == sortedMap.head {
+        return entry[K, V](nil)
+    }
+    return entry(node)
+}
+
+// Returns value of key and true, or zero value and false if key is not in the
+// map.
+func (sortedMap *SortedMap[K, V]) Get(key K) (V, bool) {
+    node := sortedMap.lowerBound(key)
+    if node == nil || sortedMap.cmp(node.key, key) != 0 {
+        var zero V
+        return zero, false
+    }
+    return node.value, true
+}
+
+// Inserts key with value into the map, replacing the value if key is already
+// in the map. Returns true if key is new.
+func (sortedMap *SortedMap[K, V]) Insert(key K, value V) bool {
+    var update [maxLevel]*skipNode[K, V]
+    node := sortedMap.search(key, &update)
+    if node != nil && sortedMap.cmp(node.key, key) == 0 {
+        node.value = value
+        return false
+    }
+
+    level := sortedMap.randomLevel()
+    for ; sortedMap.level < level; sortedMap.level++ {
+        update[sortedMap.level] = sortedMap.head
+    }
+    node = &skipNode[K, V]{key: key, value: value, next: make([]*skipNode[K, V], level)}
+    for i := range node.next {
+        node.next[i] = update[i].next[i]
+        update[i].next[i] = node
+    }
+    sortedMap.size++
+    return true
+}
+
+// Returns an iterator over keys of the map, in ascending order.
+func (sortedMap *SortedMap[K, V]) Keys() iter.Seq[K] {
+    return func(yield func(K) bool) {
+        for key := range sortedMap.All() {
+            if !yield(key) {
+                return
+            }
+        }
+    }
+}
+
+// Returns number of entries of the map.
+func (sortedMap *SortedMap[K, V]) Len() int {
+    return sortedMap.size
+}
+
+// Returns an iterator over entries which keys are in range [from, to), in
+// ascending order of keys.
+func (sortedMap *SortedMap[K, V]) Range(from, to K) iter.Seq2[K, V] {
+    return sortedMap.iterate(&from, &to)
+}
+
+// Resets the generator of node levels with seed, so the shape of the list is
+// reproducible for the same sequence of operations.
+func (sortedMap *SortedMap[K, V]) Seed(seed uint64) {
+    sortedMap.random = rand.New(rand.NewPCG(seed, seed))
+}
+
+/*
+ *******************************************************************************
+ * Internal functions and methods
+ *******************************************************************************
+ */
+
+// Returns key and value of node and true, or zero values and false if node is
+// nil.
+func entry[K, V any](node *skipNode[K, V]) (K, V, bool) {
+    if node == nil {
+        var key K
+        var value V
+        return key, value, false
+    }
+    return node.key, node.value, true
+}
+
+// Returns an iterator over entries from the first key not less than from, or
+// from the first entry if from is nil, stopping before the first key not less
+// than to if to is not nil. The first entry is searched each time iteration
+// starts, so the iterator sees the current content of the map.
+func (sortedMap *SortedMap[K, V]) iterate(from, to *K) iter.Seq2[K, V] {
+    return func(yield func(K, V) bool) {
+        node := sortedMap.head.next[0]
+        if from != nil {
+            node = sortedMap.lowerBound(*from)
+        }
+        for current := node; current != nil; current = current.next[0] {
+            if to != nil && sortedMap.cmp(current.key, *to) >= 0 {
+                return
+            }
+            if !yield(current.key, current.value) {
+                return
+            }
+        }
+    }
+}
+
+// Returns the first node which key is greater than or equal to key, or nil.
+func (sortedMap *SortedMap[K, V]) lowerBound(key K) *skipNode[K, V] {
+    return sortedMap.search(key, nil)
+}
+
+// Returns a random level for a new node, each level with probability 1/4 of
+// the level below.
+func (sortedMap *SortedMap[K, V]) randomLevel() int {
+    level := 1
+    for level < maxLevel && sortedMap.random.Uint32()%4 == 0 {
+        level++
+    }
+    return level
+}
+
+// Returns the first node which key is greater than or equal to key, or nil.
+// If update is not nil, it is filled with the last node before key at each
+// level in use.
+func (sortedMap *SortedMap[K, V]) search(key K, update *[maxLevel]*skipNode[K, V]) *skipNode[K, V] {
+    node := sortedMap.head
+    for i := sortedMap.level - 1; i >= 0; i-- {
+        for node.next[i] != nil && sortedMap.cmp(node.next[i].key, key) < 0 {
+            node = node.next[i]
+        }
+        if update != nil {
+            update[i] = node
+        }
+    }
+    return node.next[0]
+}
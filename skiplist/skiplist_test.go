@@ -0,0 +1,183 @@
+package skiplist
+
+import (
+    "math/rand/v2"
+    "slices"
+    "strings"
+    "testing"
+)
+
+// Returns levels of nodes of sortedMap, in order of keys.
+func levels[K, V any](sortedMap *SortedMap[K, V]) []int {
+    var result []int
+    for node := sortedMap.head.next[0]; node != nil; node = node.next[0] {
+        result = append(result, len(node.next))
+    }
+    return result
+}
+
+// Checks that every level of sortedMap is sorted and a sublist of the level
+// below, and that size matches.
+func checkInvariants[K, V any](t *testing.T, name string, sortedMap *SortedMap[K, V]) {
+    t.Helper()
+    size := 0
+    for node := sortedMap.head.next[0]; node != nil; node = node.next[0] {
+        size++
+    }
+    if size != sortedMap.size {
+        t.Errorf("%s\nsize: %v\nexpected: %v", name, sortedMap.size, size)
+    }
+    for i := 1; i < maxLevel; i++ {
+        below := sortedMap.head.next[i-1]
+        for node := sortedMap.head.next[i]; node != nil; node = node.next[i] {
+            for below != nil && below != node {
+                below = below.next[i-1]
+            }
+            if below == nil {
+                t.Fatalf("%s\nlevel %v is not a sublist of level %v", name, i, i-1)
+            }
+            if next := node.next[i]; next != nil && sortedMap.cmp(node.key, next.key) >= 0 {
+                t.Fatalf("%s\nlevel %v is not sorted", name, i)
+            }
+        }
+    }
+}
+
+func TestSortedMap(t *testing.T) {
+    sortedMap := NewSortedMap[int, string]()
+    sortedMap.Seed(1)
+    for _, key := range rand.New(rand.NewPCG(1, 2)).Perm(1000) {
+        if !sortedMap.Insert(key*2, "v") {
+            t.Fatalf("Insert\nresult: false\nexpected: true")
+        }
+    }
+    checkInvariants(t, "Insert", sortedMap)
+    if sortedMap.Insert(10, "ten") {
+        t.Errorf("Insert\nresult: true\nexpected: false")
+    }
+    if value, ok := sortedMap.Get(10); !ok || value != "ten" {
+        t.Errorf("Get\nresult: %v, %v\nexpected: ten, true", value, ok)
+    }
+    if sortedMap.Contains(11) || !sortedMap.Contains(1998) {
+        t.Errorf("Contains\nresult: false\nexpected: true")
+    }
+    if key, _, ok := sortedMap.Floor(11); !ok || key != 10 {
+        t.Errorf("Floor\nresult: %v, %v\nexpected: 10, true", key, ok)
+    }
+    if key, _, ok := sortedMap.Ceiling(11); !ok || key != 12 {
+        t.Errorf("Ceiling\nresult: %v, %v\nexpected: 12, true", key, ok)
+    }
+    if _, _, ok := sortedMap.Floor(-1); ok {
+        t.Errorf("Floor\nresult: true\nexpected: false")
+    }
+    if _, _, ok := sortedMap.Ceiling(1999); ok {
+        t.Errorf("Ceiling\nresult: true\nexpected: false")
+    }
+
+    for key := 0; key < 2000; key += 4 {
+        if !sortedMap.Delete(key) {
+            t.Fatalf("Delete\nresult: false\nexpected: true")
+        }
+    }
+    checkInvariants(t, "Delete", sortedMap)
+    if sortedMap.Delete(0) || sortedMap.Len() != 500 {
+        t.Errorf("Delete\nresult: %v\nexpected: %v", sortedMap.Len(), 500)
+    }
+    if result, expected := slices.Collect(sortedMap.Keys())[:3], []int{2, 6, 10}; !slices.Equal(result, expected) {
+        t.Errorf("Keys\nresult: %v\nexpected: %v", result, expected)
+    }
+}
+
+func TestSortedMap_Range(t *testing.T) {
+    sortedMap := NewSortedMap[int, int]()
+    for i := 0; i < 100; i += 10 {
+        sortedMap.Insert(i, i*i)
+    }
+    var keys, values []int
+    for key, value := range sortedMap.Range(15, 50) {
+        keys, values = append(keys, key), append(values, value)
+    }
+    if expected := []int{20, 30, 40}; !slices.Equal(keys, expected) {
+        t.Errorf("Range\nresult: %v\nexpected: %v", keys, expected)
+    }
+    if expected := []int{400, 900, 1600}; !slices.Equal(values, expected) {
+        t.Errorf("Range\nresult: %v\nexpected: %v", values, expected)
+    }
+    all := sortedMap.All()
+    for range all {
+        break
+    }
+    count := 0
+    for range all {
+        count++
+    }
+    if count != 10 {
+        t.Errorf("All\nresult: %v\nexpected: %v", count, 10)
+    }
+}
+
+func TestSortedMap_IterateAfterChange(t *testing.T) {
+    sortedMap := NewSortedMap[int, string]()
+    sortedMap.Insert(20, "b")
+    all, partial := sortedMap.All(), sortedMap.Range(10, 30)
+    sortedMap.Insert(10, "a")
+    sortedMap.Insert(15, "c")
+    sortedMap.Delete(20)
+    if result, expected := slices.Collect(sortedMap.Keys()), []int{10, 15}; !slices.Equal(result, expected) {
+        t.Errorf("Keys\nresult: %v\nexpected: %v", result, expected)
+    }
+    var keys []int
+    for key := range all {
+        keys = append(keys, key)
+    }
+    if expected := []int{10, 15}; !slices.Equal(keys, expected) {
+        t.Errorf("All\nresult: %v\nexpected: %v", keys, expected)
+    }
+    keys = nil
+    for key := range partial {
+        keys = append(keys, key)
+    }
+    if expected := []int{10, 15}; !slices.Equal(keys, expected) {
+        t.Errorf("Range\nresult: %v\nexpected: %v", keys, expected)
+    }
+}
+
+func TestSortedMap_Func(t *testing.T) {
+    sortedMap := NewSortedMapFunc[string, int](func(a, b string) int {
+        return strings.Compare(strings.ToLower(a), strings.ToLower(b))
+    })
+    sortedMap.Insert("b", 1)
+    sortedMap.Insert("A", 2)
+    sortedMap.Insert("a", 3)
+    if result, expected := slices.Collect(sortedMap.Keys()), []string{"A", "b"}; !slices.Equal(result, expected) {
+        t.Errorf("Keys\nresult: %v\nexpected: %v", result, expected)
+    }
+    if value, _ := sortedMap.Get("a"); value != 3 {
+        t.Errorf("Get\nresult: %v\nexpected: %v", value, 3)
+    }
+}
+
+func TestSeed(t *testing.T) {
+    build := func() *SortedMap[int, int] {
+        sortedMap := NewSortedMap[int, int]()
+        sortedMap.Seed(42)
+        for i := 0; i < 500; i++ {
+            sortedMap.Insert(i, i)
+        }
+        return sortedMap
+    }
+    if result, expected := levels(build()), levels(build()); !slices.Equal(result, expected) {
+        t.Errorf("Seed\nlevels of nodes differ for the same seed")
+    }
+}
+
+func BenchmarkSortedMap_Get(b *testing.B) {
+    sortedMap := NewSortedMap[int, int]()
+    for i := 0; i < 100000; i++ {
+        sortedMap.Insert(i, i)
+    }
+    b.ResetTimer()
+    for i := 0; i < b.N; i++ {
+        sortedMap.Get(i % 100000)
+    }
+}
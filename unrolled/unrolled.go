@@ -0,0 +1,1295 @@
+// Package unrolled contains functions and methods for unrolled linked list in
+// Go, which nodes hold small fixed-capacity arrays of elements.
+package unrolled
+
+import (
+    "cmp"
+    "container/heap"
+    "errors"
+    "io"
+    "iter"
+    "slices"
+    "strconv"
+    "strings"
+    "github.com/hiennguyen-neih/go-linkedlist/internal/listtext"
+    "github.com/hiennguyen-neih/go-linkedlist/golist"
+    "github.com/hiennguyen-neih/go-linkedlist/golist2"
+    "github.com/hiennguyen-neih/go-linkedlist/constraints"
+)
+
+/*
+ *******************************************************************************
+ * Define structs and interfaces
+ *******************************************************************************
+ */
+
+// Maximum number of elements held by one node of the list.
+const chunkSize = 64
+
+// Node of unrolled linked list, holding up to chunkSize elements.
+type chunk[T any] struct {
+    next   *chunk[T]          // Pointer to next node of the list.
+    count  int                // Number of elements used in values.
+    values [chunkSize]T
+}
+
+// Struct of Go unrolled linked list. Elements are packed in nodes of up to 64
+// elements, so traversing the list touches contiguous memory and needs one
+// allocation per node instead of per element. The zero value is an empty list.
+//
+// Like golist.GoList, functions return new lists and never modify their input.
+// Nodes are not exported, so elements are accessed by index instead. A List
+// copied by assignment shares its nodes with the original, so the in-place
+// methods must not be called on a list while copies of it are still in use,
+// use Concat to get an independent copy first.
+type List[T any] struct {
+    head *chunk[T]    // First node of the list.
+    tail *chunk[T]    // Last node of the list.
+    size int          // Number of elements in the list.
+}
+
+// Errors returned by the Try and Parse functions of the package.
+var (
+    ErrEmptyList       = errors.New("unrolled: list is empty")
+    ErrIndexOutOfRange = errors.New("unrolled: index out of range")
+    ErrNegativeLength  = errors.New("unrolled: negative length")
+    ErrSyntax          = errors.New("unrolled: invalid list syntax")
+)
+
+// Text format of the list, such as ["a"->"b"], written by WriteTo and read by
+// ParseReader. It is the format of golist.GoList.
+var textFormat = listtext.Format{
+    Sep:       "->",
+    ErrSyntax: ErrSyntax,
+}
+
+/*
+ *******************************************************************************
+ * Exported functions
+ *******************************************************************************
+ */
+
+// Returns a new unrolled list containing all input elements.
+func New[T any](elems ...T) List[T] {
+    return FromSlice(elems)
+}
+
+// Returns a new unrolled list containing all elements of slice.
+func FromSlice[T any](slice []T) List[T] {
+    var result List[T]
+    for _, elem := range slice {
+        result.append(elem)
+    }
+    return result
+}
+
+// Returns a new unrolled list containing all values yielded by seq.
+func FromSeq[T any](seq iter.Seq[T]) List[T] {
+    var result List[T]
+    for elem := range seq {
+        result.append(elem)
+    }
+    return result
+}
+
+// Returns a new unrolled list containing node data of list.
+func FromList[T any](list golist.GoList[T]) List[T] {
+    return FromSeq(list.All())
+}
+
+// Returns a new unrolled list containing node data of list.
+func FromList2[T any](list golist2.GoList2[T]) List[T] {
+    return FromSeq(list.All())
+}
+
+// Returns a slice containing all elements of list.
+func ToSlice[T any](list List[T]) []T {
+    result := make([]T, 0, list.size)
+    for chunk := list.head; chunk != nil; chunk = chunk.next {
+        result = append(result, chunk.values[:chunk.count]...)
+    }
+    return result
+}
+
+// Returns a golist.GoList containing all elements of list.
+func ToList[T any](list List[T]) golist.GoList[T] {
+    return golist.FromSeq(list.All())
+}
+
+// Returns a golist2.GoList2 containing all elements of list.
+func ToList2[T any](list List[T]) golist2.GoList2[T] {
+    return golist2.FromSeq(list.All())
+}
+
+// Returns true if fun returns true for all elements in list, otherwise returns
+// false.
+func All[T any](list List[T], fun func(T) bool) bool {
+    for elem := range list.All() {
+        if !fun(elem) {
+            return false
+        }
+    }
+    return true
+}
+
+// Returns true if fun returns true for at least one element in list, otherwise
+// returns false.
+func Any[T any](list List[T], fun func(T) bool) bool {
+    for elem := range list.All() {
+        if fun(elem) {
+            return true
+        }
+    }
+    return false
+}
+
+// Returns a list that input elements are appended to the end of list.
+func Append[T any](list List[T], elems ...T) List[T] {
+    result := Concat(list)
+    for _, elem := range elems {
+        result.append(elem)
+    }
+    return result
+}
+
+// Returns a list that input elements are appended to the start of list.
+func AppendHead[T any](list List[T], elems ...T) List[T] {
+    return Concat(New(elems...), list)
+}
+
+// Collects values from input iterator into new list. This function is the
+// same as FromSeq, named after slices.Collect.
+func Collect[T any](seq iter.Seq[T]) List[T] {
+    return FromSeq(seq)
+}
+
+// Returns a list that is concatenated of all input lists.
+func Concat[T any](lists ...List[T]) List[T] {
+    var result List[T]
+    for _, list := range lists {
+        for elem := range list.All() {
+            result.append(elem)
+        }
+    }
+    return result
+}
+
+// Returns a copy of list where the first element that is equal to value is
+// deleted, if there is such an element.
+func Delete[T comparable](list List[T], value T) List[T] {
+    return DeleteFunc(list, func(elem T) bool { return elem == value })
+}
+
+// Returns a copy of list where the first element that fun returns true is
+// deleted, if there is such an element.
+func DeleteFunc[T any](list List[T], fun func(T) bool) List[T] {
+    var result List[T]
+    deleted := false
+    for elem := range list.All() {
+        if !deleted && fun(elem) {
+            deleted = true
+            continue
+        }
+        result.append(elem)
+    }
+    return result
+}
+
+// Deletes element at the specific index of list. If index is out of bound,
+// the original list is returned. Negative index indicate an offset from the
+// end of list. See TryDeleteAt for a variant returning an error.
+func DeleteAt[T any](list List[T], index int) List[T] {
+    result, err := TryDeleteAt(list, index)
+    if err != nil {
+        return Concat(list)
+    }
+    return result
+}
+
+// Returns a list of distinct elements of list1 that are not in list2, in order
+// of their first occurrence in list1. Unlike Subtract, duplicates are removed
+// from the result.
+func Difference[T comparable](list1, list2 List[T]) List[T] {
+    set2 := toSet(list2)
+    return Uniq(Filter(list1, func(elem T) bool {
+        _, found := set2[elem]
+        return !found
+    }))
+}
+
+// Drops the last element of input list. If input list is an empty list,
+// returns an empty list.
+func DropLast[T any](list List[T]) List[T] {
+    var result List[T]
+    for i, elem := range list.Enumerate() {
+        if i == list.size-1 {
+            break
+        }
+        result.append(elem)
+    }
+    return result
+}
+
+// Drops elements from list while fun returns true and returns the remaining
+// list.
+func DropWhile[T any](list List[T], fun func(T) bool) List[T] {
+    var result List[T]
+    dropping := true
+    for elem := range list.All() {
+        if dropping && fun(elem) {
+            continue
+        }
+        dropping = false
+        result.append(elem)
+    }
+    return result
+}
+
+// Returns a list containing n copies of term elem. If n is negative or equal
+// 0, return empty list.
+func Duplicate[T any](n int, elem T) List[T] {
+    var result List[T]
+    for i := 0; i < n; i++ {
+        result.append(elem)
+    }
+    return result
+}
+
+// Returns true if both lists have the same length and equal elements in the
+// same order, compared with ==.
+func Equal[T comparable](list1, list2 List[T]) bool {
+    return EqualFunc(list1, list2, func(a, b T) bool { return a == b })
+}
+
+// Returns true if both lists have the same length and eq returns true for
+// each pair of elements at the same index.
+func EqualFunc[T1, T2 any](list1 List[T1], list2 List[T2], eq func(T1, T2) bool) bool {
+    if list1.size != list2.size {
+        return false
+    }
+    next, stop := iter.Pull(list2.All())
+    defer stop()
+    for elem1 := range list1.All() {
+        if elem2, _ := next(); !eq(elem1, elem2) {
+            return false
+        }
+    }
+    return true
+}
+
+// Returns a list of all elements in list that fun returns true.
+func Filter[T any](list List[T], fun func(T) bool) List[T] {
+    var result List[T]
+    for elem := range list.All() {
+        if fun(elem) {
+            result.append(elem)
+        }
+    }
+    return result
+}
+
+// Calls fun(elem) on successive elements of list to update or remove them. If
+// fun returns (true, value), value is kept in the result, otherwise the
+// element is dropped.
+func FilterMap[T1, T2 any](list List[T1], fun func(T1) (bool, T2)) List[T2] {
+    var result List[T2]
+    for elem := range list.All() {
+        if ok, value := fun(elem); ok {
+            result.append(value)
+        }
+    }
+    return result
+}
+
+// Returns position of first element of list that is equal to value. If there
+// is no such element, returns -1. Elements are compared with ==, see
+// IndexFunc for other cases.
+func Find[T comparable](list List[T], value T) int {
+    return IndexFunc(list, func(elem T) bool { return elem == value })
+}
+
+// Calls fun(elem) on every element of list and returns a list concatenated of
+// the returned lists.
+func FlatMap[T1, T2 any](list List[T1], fun func(T1) List[T2]) List[T2] {
+    var result List[T2]
+    for elem := range list.All() {
+        for value := range fun(elem).All() {
+            result.append(value)
+        }
+    }
+    return result
+}
+
+// Calls fun(elem, acc) on successive elements of list from left to right,
+// starting with acc0, and returns the final value of the accumulator.
+func Foldl[T1, T2 any](list List[T1], acc0 T2, fun func(T1, T2) T2) T2 {
+    for elem := range list.All() {
+        acc0 = fun(elem, acc0)
+    }
+    return acc0
+}
+
+// Calls fun(elem, acc) on successive elements of list from right to left,
+// starting with acc0, and returns the final value of the accumulator.
+func Foldr[T1, T2 any](list List[T1], acc0 T2, fun func(T1, T2) T2) T2 {
+    elems := ToSlice(list)
+    for i := len(elems) - 1; i >= 0; i-- {
+        acc0 = fun(elems[i], acc0)
+    }
+    return acc0
+}
+
+// Calls fun(elem) for each element in list, from first to last.
+func ForEach[T any](list List[T], fun func(T)) {
+    for elem := range list.All() {
+        fun(elem)
+    }
+}
+
+// Returns position of first element of list that fun returns true. If every
+// fun execution returns false, returns -1.
+func IndexFunc[T any](list List[T], fun func(T) bool) int {
+    for i, elem := range list.Enumerate() {
+        if fun(elem) {
+            return i
+        }
+    }
+    return -1
+}
+
+// Returns a list with val is inserted at specific index. Negative index
+// indicate an offset from the end of list. Panics if index is out of bound,
+// see TryInsertAt for a variant returning an error.
+func InsertAt[T any](list List[T], index int, val T) List[T] {
+    result, err := TryInsertAt(list, index, val)
+    if err != nil {
+        panic("InsertAt, index is out of bound!")
+    }
+    return result
+}
+
+// Returns a list of distinct elements of list1 that are also in list2, in
+// order of their first occurrence in list1.
+func Intersect[T comparable](list1, list2 List[T]) List[T] {
+    set2 := toSet(list2)
+    return Uniq(Filter(list1, func(elem T) bool {
+        _, found := set2[elem]
+        return found
+    }))
+}
+
+// Inserts sep between each element in list. This function has no effect on an
+// empty list or a singleton list.
+func Join[T any](list List[T], sep T) List[T] {
+    var result List[T]
+    for i, elem := range list.Enumerate() {
+        if i > 0 {
+            result.append(sep)
+        }
+        result.append(elem)
+    }
+    return result
+}
+
+// Returns the last element of list, or zero value if list is empty. See
+// TryLast for a variant returning an error.
+func Last[T any](list List[T]) T {
+    last, _ := TryLast(list)
+    return last
+}
+
+// Returns the number of elements in list.
+func Len[T any](list List[T]) int {
+    return list.size
+}
+
+// Calls fun(elem) to every elements in list and returns a list contains
+// returned values of that fun.
+func Map[T1, T2 any](list List[T1], fun func(T1) T2) List[T2] {
+    var result List[T2]
+    for elem := range list.All() {
+        result.append(fun(elem))
+    }
+    return result
+}
+
+// Combines the operations of Map and Foldl into one pass.
+func MapFoldl[T1, T2, T3 any](list List[T1], acc0 T2, fun func(T1, T2) (T3, T2)) (List[T3], T2) {
+    var value T3
+    var result List[T3]
+    for elem := range list.All() {
+        value, acc0 = fun(elem, acc0)
+        result.append(value)
+    }
+    return result, acc0
+}
+
+// Combines the operations of Map and Foldr into one pass.
+func MapFoldr[T1, T2, T3 any](list List[T1], acc0 T2, fun func(T1, T2) (T3, T2)) (List[T3], T2) {
+    var value T3
+    elems := ToSlice(list)
+    values := make([]T3, len(elems))
+    for i := len(elems) - 1; i >= 0; i-- {
+        value, acc0 = fun(elems[i], acc0)
+        values[i] = value
+    }
+    return FromSlice(values), acc0
+}
+
+// Returns the first element of list that compares greater than or equal to
+// all other elements, or zero value if list is empty. See TryMax for a
+// variant returning an error.
+func Max[T constraints.Ordered](list List[T]) T {
+    return MaxFunc(list, cmp.Compare[T])
+}
+
+// Returns the first element of list that compares greater than or equal to
+// all other elements, using cmp to compare them, or zero value if list is
+// empty. See TryMaxFunc for a variant returning an error.
+func MaxFunc[T any](list List[T], cmp func(a, b T) int) T {
+    max, _ := TryMaxFunc(list, cmp)
+    return max
+}
+
+// Returns true if value is an element of list, otherwise false.
+func Member[T comparable](list List[T], value T) bool {
+    return Any(list, func(elem T) bool { return elem == value })
+}
+
+// Returns a sorted list formed by merging all input lists. When all input
+// lists are sorted, they are merged in linear time. This function only works
+// with constraint Ordered lists.
+func Merge[T constraints.Ordered](lists ...List[T]) List[T] {
+    return MergeFunc(cmp.Compare[T], lists...)
+}
+
+// Returns a sorted list formed by merging all input lists, using cmp to
+// compare elements. When all input lists are sorted, they are merged in
+// linear time. Elements that compare equal keep their input order.
+func MergeFunc[T any](cmp func(a, b T) int, lists ...List[T]) List[T] {
+    if !allSortedFunc(lists, cmp) {
+        return SortStableFunc(Concat(lists...), cmp)
+    }
+    return mergeFunc(lists, cmp, false)
+}
+
+// Returns the first element of list that compares less than or equal to all
+// other elements, or zero value if list is empty. See TryMin for a variant
+// returning an error.
+func Min[T constraints.Ordered](list List[T]) T {
+    return MinFunc(list, cmp.Compare[T])
+}
+
+// Returns the first element of list that compares less than or equal to all
+// other elements, using cmp to compare them, or zero value if list is empty.
+// See TryMinFunc for a variant returning an error.
+func MinFunc[T any](list List[T], cmp func(a, b T) int) T {
+    min, _ := TryMinFunc(list, cmp)
+    return min
+}
+
+// Returns element of list at specific index. Negative index indicate an
+// offset from the end of list. Panics if index is out of bound, see TryNth for
+// a variant returning an error.
+func Nth[T any](list List[T], index int) T {
+    elem, err := TryNth(list, index)
+    if err != nil {
+        panic("Nth, index is out of bound!")
+    }
+    return elem
+}
+
+// Returns sublist from element in list at specific index. Negative index
+// indicate an offset from the end of list. Panics if index is out of bound,
+// see TryNthTail for a variant returning an error.
+func NthTail[T any](list List[T], index int) List[T] {
+    result, err := TryNthTail(list, index)
+    if err != nil {
+        panic("NthTail, index is out of bound!")
+    }
+    return result
+}
+
+// Parses str in the format produced by String method, such as ["a"->"b"], into a
+// new list. Each element is decoded from its text by decode. Returns an error
+// wrapping ErrSyntax if str is not in that format, or the error returned by
+// decode.
+func Parse[T any](str string, decode func(string) (T, error)) (List[T], error) {
+    return ParseReader(strings.NewReader(str), decode)
+}
+
+// Parses str into a new list of numbers. Same as Parse, with elements decoded
+// as they are formatted by %v.
+func ParseNumbers[T constraints.Numeric](str string) (List[T], error) {
+    return Parse(str, func(token string) (T, error) {
+        return listtext.ParseNumber[T](token, ErrSyntax)
+    })
+}
+
+// Same as Parse, but reading the text from r. The reader is consumed
+// incrementally, so the whole text is never held in memory. Only white
+// spaces may follow the closing bracket.
+func ParseReader[T any](r io.Reader, decode func(string) (T, error)) (List[T], error) {
+    var result List[T]
+    add := func(value T) { result.append(value) }
+    if err := listtext.Read(textFormat, r, decode, add); err != nil {
+        return List[T]{}, err
+    }
+    return result, nil
+}
+
+// Parses str into a new list of strings. Same as Parse, with elements decoded
+// as Go quoted strings, which is how String method formats them.
+func ParseStrings(str string) (List[string], error) {
+    return Parse(str, strconv.Unquote)
+}
+
+// Partitions input list into list1 and list2, where list1 contains elements
+// which fun returns true and list2 contains elements which fun returns false.
+func Partition[T any](list List[T], fun func(T) bool) (List[T], List[T]) {
+    var list1 List[T]
+    var list2 List[T]
+    for elem := range list.All() {
+        if fun(elem) {
+            list1.append(elem)
+        } else {
+            list2.append(elem)
+        }
+    }
+    return list1, list2
+}
+
+// Returns true if list1 is a prefix of list2, otherwise returns false.
+// A prefix of a list is the first part of the list, starting from the
+// beginning and stopping at any point.
+func Prefix[T comparable](list1, list2 List[T]) bool {
+    if list1.size > list2.size {
+        return false
+    }
+    next, stop := iter.Pull(list2.All())
+    defer stop()
+    for elem1 := range list1.All() {
+        if elem2, _ := next(); elem1 != elem2 {
+            return false
+        }
+    }
+    return true
+}
+
+// Returns a list that element at specific index is replaced with val. If
+// index is out of bound, the original list is returned. Negative index
+// indicate an offset from the end of list. See TryReplaceAt for a variant
+// returning an error.
+func ReplaceAt[T any](list List[T], index int, val T) List[T] {
+    return UpdateAt(list, index, func(T) T { return val })
+}
+
+// Returns a list containing the elements of input list in reverse order.
+func Reverse[T any](list List[T]) List[T] {
+    elems := ToSlice(list)
+    slices.Reverse(elems)
+    return FromSlice(elems)
+}
+
+// Returns position and first element in list that fun returns true. If every
+// fun execution returns false, returns position is -1 and zero value.
+func Search[T any](list List[T], fun func(T) bool) (int, T) {
+    for i, elem := range list.Enumerate() {
+        if fun(elem) {
+            return i, elem
+        }
+    }
+    var zero T
+    return -1, zero
+}
+
+// Returns sequence of numbers that starts with from and contains the
+// successive results of adding incr to the previous element, until to is
+// reached or passed (in later case, to is not an element of the sequence).
+func Seq[T constraints.Numeric](from, to, incr T) List[T] {
+    var result List[T]
+    for i := from; i <= to; i += incr {
+        result.append(i)
+    }
+    return result
+}
+
+// Returns a list containing the sorted elements of list. This function only
+// works with constraint Ordered list.
+func Sort[T constraints.Ordered](list List[T]) List[T] {
+    elems := ToSlice(list)
+    slices.Sort(elems)
+    return FromSlice(elems)
+}
+
+// Returns a list containing the elements of list sorted as determined by cmp.
+// The sort is not guaranteed to be stable, see SortStableFunc.
+func SortFunc[T any](list List[T], cmp func(a, b T) int) List[T] {
+    elems := ToSlice(list)
+    slices.SortFunc(elems, cmp)
+    return FromSlice(elems)
+}
+
+// Same as SortFunc, but keeps the original order of equal elements.
+func SortStableFunc[T any](list List[T], cmp func(a, b T) int) List[T] {
+    elems := ToSlice(list)
+    slices.SortStableFunc(elems, cmp)
+    return FromSlice(elems)
+}
+
+// Split input list into list1 and list2, list1 contains n first elements and
+// list2 contains the remaining elements. Negative n indicate an offset from
+// the end of list. Panics if n is out of bound, see TrySplit for a variant
+// returning an error.
+func Split[T any](list List[T], n int) (List[T], List[T]) {
+    list1, list2, err := TrySplit(list, n)
+    if err != nil {
+        panic("Split, n is out of bound!")
+    }
+    return list1, list2
+}
+
+// Splits input list into list1 and list2, list1 contains elements which fun
+// returns true until the first element fun returns false, and list2 contains
+// the remaining elements.
+func SplitWith[T any](list List[T], fun func(T) bool) (List[T], List[T]) {
+    var list1 List[T]
+    var list2 List[T]
+    taking := true
+    for elem := range list.All() {
+        if taking && fun(elem) {
+            list1.append(elem)
+            continue
+        }
+        taking = false
+        list2.append(elem)
+    }
+    return list1, list2
+}
+
+// Returns sublist of input list, starting at start and has maximum len
+// elements. Negative start indicate an offset from the end of list. It is not
+// an error for start + len to exceed the length of list. Panics if start is
+// out of bound or len is negative, see TrySublist for a variant returning an
+// error.
+func Sublist[T any](list List[T], start, len int) List[T] {
+    result, err := TrySublist(list, start, len)
+    if err == ErrNegativeLength {
+        panic("Sublist, input len must not be negative!")
+    } else if err != nil {
+        panic("Sublist, start is out of bound!")
+    }
+    return result
+}
+
+// Returns a new list that is a copy of list1 which is for each element in
+// list2, its first occurrence in list1 is deleted. Elements are compared with
+// ==, see SubtractFunc for other cases.
+func Subtract[T comparable](list1, list2 List[T]) List[T] {
+    counts := make(map[T]int) // number of occurrences to delete
+    for elem2 := range list2.All() {
+        counts[elem2]++
+    }
+
+    var result List[T]
+    for elem1 := range list1.All() {
+        if counts[elem1] > 0 {
+            counts[elem1]--
+            continue
+        }
+        result.append(elem1)
+    }
+    return result
+}
+
+// Same as Subtract, but using eq to compare elements of list1 and list2.
+func SubtractFunc[T any](list1, list2 List[T], eq func(T, T) bool) List[T] {
+    elems := ToSlice(list1)
+    for elem2 := range list2.All() {
+        index := slices.IndexFunc(elems, func(elem1 T) bool { return eq(elem1, elem2) })
+        if index >= 0 {
+            elems = slices.Delete(elems, index, index+1)
+        }
+    }
+    return FromSlice(elems)
+}
+
+// Returns true if list1 is a suffix of list2, otherwise returns false.
+// A suffix of a list is the last part of the list, starting from any point
+// and stopping at the end.
+func Suffix[T comparable](list1, list2 List[T]) bool {
+    if list1.size > list2.size {
+        return false
+    }
+    return Prefix(list1, NthTail(list2, list2.size-list1.size))
+}
+
+// Returns a list of distinct elements that are in exactly one of list1 and
+// list2. Elements of list1 come first, each in order of first occurrence.
+func SymmetricDifference[T comparable](list1, list2 List[T]) List[T] {
+    return Concat(Difference(list1, list2), Difference(list2, list1))
+}
+
+// Returns sum of all elements in list. This function only works with
+// constraint Numeric list.
+func Sum[T constraints.Numeric](list List[T]) T {
+    var sum T
+    for elem := range list.All() {
+        sum += elem
+    }
+    return sum
+}
+
+// Takes elements from list while fun returns true and returns them.
+func TakeWhile[T any](list List[T], fun func(T) bool) List[T] {
+    var result List[T]
+    for elem := range list.All() {
+        if !fun(elem) {
+            break
+        }
+        result.append(elem)
+    }
+    return result
+}
+
+// Deletes element at the specific index of list. Negative index indicate an
+// offset from the end of list. Returns ErrEmptyList if list is empty and
+// ErrIndexOutOfRange if index is out of bound.
+func TryDeleteAt[T any](list List[T], index int) (List[T], error) {
+    index, err := elemIndex(index, list.size)
+    if err != nil {
+        return List[T]{}, err
+    }
+
+    var result List[T]
+    for i, elem := range list.Enumerate() {
+        if i != index {
+            result.append(elem)
+        }
+    }
+    return result, nil
+}
+
+// Returns a list with val is inserted at specific index. Negative index
+// indicate an offset from the end of list. Returns ErrIndexOutOfRange if index
+// is out of bound.
+func TryInsertAt[T any](list List[T], index int, val T) (List[T], error) {
+    if index < 0 {
+        index = list.size + index // same as len - abs(index)
+    }
+    if index < 0 || index > list.size {
+        return List[T]{}, ErrIndexOutOfRange
+    }
+
+    var result List[T]
+    for i, elem := range list.Enumerate() {
+        if i == index {
+            result.append(val)
+        }
+        result.append(elem)
+    }
+    if index == list.size {
+        result.append(val)
+    }
+    return result, nil
+}
+
+// Returns the last element of list. Returns ErrEmptyList if list is empty.
+func TryLast[T any](list List[T]) (T, error) {
+    if list.tail == nil {
+        var zero T
+        return zero, ErrEmptyList
+    }
+    return list.tail.values[list.tail.count-1], nil
+}
+
+// Returns the first element of list that compares greater than or equal to
+// all other elements. Returns ErrEmptyList if list is empty.
+func TryMax[T constraints.Ordered](list List[T]) (T, error) {
+    return TryMaxFunc(list, cmp.Compare[T])
+}
+
+// Returns the first element of list that compares greater than or equal to
+// all other elements, using cmp to compare them. Returns ErrEmptyList if list
+// is empty.
+func TryMaxFunc[T any](list List[T], cmp func(a, b T) int) (T, error) {
+    if list.head == nil {
+        var zero T
+        return zero, ErrEmptyList
+    }
+    max := list.head.values[0]
+    for elem := range list.All() {
+        if cmp(elem, max) > 0 {
+            max = elem
+        }
+    }
+    return max, nil
+}
+
+// Returns the first element of list that compares less than or equal to all
+// other elements. Returns ErrEmptyList if list is empty.
+func TryMin[T constraints.Ordered](list List[T]) (T, error) {
+    return TryMinFunc(list, cmp.Compare[T])
+}
+
+// Returns the first element of list that compares less than or equal to all
+// other elements, using cmp to compare them. Returns ErrEmptyList if list is
+// empty.
+func TryMinFunc[T any](list List[T], cmp func(a, b T) int) (T, error) {
+    if list.head == nil {
+        var zero T
+        return zero, ErrEmptyList
+    }
+    min := list.head.values[0]
+    for elem := range list.All() {
+        if cmp(elem, min) < 0 {
+            min = elem
+        }
+    }
+    return min, nil
+}
+
+// Returns element of list at specific index, skipping whole nodes on the way.
+// Negative index indicate an offset from the end of list. Returns ErrEmptyList
+// if list is empty and ErrIndexOutOfRange if index is out of bound.
+func TryNth[T any](list List[T], index int) (T, error) {
+    index, err := elemIndex(index, list.size)
+    if err != nil {
+        var zero T
+        return zero, err
+    }
+    chunk, offset := list.locate(index)
+    return chunk.values[offset], nil
+}
+
+// Returns sublist from element in list at specific index. Negative index
+// indicate an offset from the end of list. Returns ErrEmptyList if list is
+// empty and ErrIndexOutOfRange if index is out of bound.
+func TryNthTail[T any](list List[T], index int) (List[T], error) {
+    return TrySublist(list, index, list.size)
+}
+
+// Returns a list that element at specific index is replaced with val.
+// Negative index indicate an offset from the end of list. Returns ErrEmptyList
+// if list is empty and ErrIndexOutOfRange if index is out of bound.
+func TryReplaceAt[T any](list List[T], index int, val T) (List[T], error) {
+    return TryUpdateAt(list, index, func(T) T { return val })
+}
+
+// Split input list into list1 and list2, list1 contains n first elements and
+// list2 contains the remaining elements. Negative n indicate an offset from
+// the end of list. Returns ErrEmptyList if list is empty and
+// ErrIndexOutOfRange if n is out of bound.
+func TrySplit[T any](list List[T], n int) (List[T], List[T], error) {
+    n, err := elemIndex(n, list.size)
+    if err != nil {
+        return List[T]{}, List[T]{}, err
+    }
+
+    var list1 List[T]
+    var list2 List[T]
+    for i, elem := range list.Enumerate() {
+        if i < n {
+            list1.append(elem)
+        } else {
+            list2.append(elem)
+        }
+    }
+    return list1, list2, nil
+}
+
+// Returns sublist of input list, starting at start and has maximum len
+// elements. Negative start indicate an offset from the end of list. Returns
+// ErrNegativeLength if len is negative, ErrEmptyList if list is empty and
+// ErrIndexOutOfRange if start is out of bound.
+func TrySublist[T any](list List[T], start, len int) (List[T], error) {
+    if len < 0 {
+        return List[T]{}, ErrNegativeLength
+    }
+    start, err := elemIndex(start, list.size)
+    if err != nil {
+        return List[T]{}, err
+    }
+
+    var result List[T]
+    chunk, offset := list.locate(start)
+    for ; chunk != nil && result.size < len; chunk, offset = chunk.next, 0 {
+        for _, elem := range chunk.values[offset:chunk.count] {
+            if result.size == len {
+                break
+            }
+            result.append(elem)
+        }
+    }
+    return result, nil
+}
+
+// Returns a list that element at specific index is updated with returns value
+// of fun. Negative index indicate an offset from the end of list. Returns
+// ErrEmptyList if list is empty and ErrIndexOutOfRange if index is out of
+// bound.
+func TryUpdateAt[T any](list List[T], index int, fun func(T) T) (List[T], error) {
+    index, err := elemIndex(index, list.size)
+    if err != nil {
+        return List[T]{}, err
+    }
+
+    result := Concat(list)
+    chunk, offset := result.locate(index)
+    chunk.values[offset] = fun(chunk.values[offset])
+    return result, nil
+}
+
+// Returns a list of distinct elements of list1 and list2, in order of their
+// first occurrence in list1 and then in list2.
+func Union[T comparable](list1, list2 List[T]) List[T] {
+    return Uniq(Concat(list1, list2))
+}
+
+// Returns a copy of list keeping only the first occurrence of each element.
+// Unlike USort, the order of elements is preserved.
+func Uniq[T comparable](list List[T]) List[T] {
+    return UniqBy(list, func(elem T) T { return elem })
+}
+
+// Returns a copy of list keeping only the first element for each key returned
+// by fun. The order of elements is preserved.
+func UniqBy[T any, K comparable](list List[T], fun func(T) K) List[T] {
+    var result List[T]
+    seen := make(map[K]bool) // store already seen keys into map
+    for elem := range list.All() {
+        key := fun(elem)
+        if seen[key] {
+            continue
+        }
+        seen[key] = true
+        result.append(elem)
+    }
+    return result
+}
+
+// Returns a sorted list formed by merging all input lists, while removing
+// duplicates. When all input lists are sorted, they are merged in linear
+// time. This function only works with constraint Ordered lists.
+func UMerge[T constraints.Ordered](lists ...List[T]) List[T] {
+    return UMergeFunc(cmp.Compare[T], lists...)
+}
+
+// Returns a sorted list formed by merging all input lists, using cmp to
+// compare elements, keeping only the first occurrence of elements that
+// compare equal. When all input lists are sorted, they are merged in linear
+// time.
+func UMergeFunc[T any](cmp func(a, b T) int, lists ...List[T]) List[T] {
+    if !allSortedFunc(lists, cmp) {
+        return USortFunc(Concat(lists...), cmp)
+    }
+    return mergeFunc(lists, cmp, true)
+}
+
+// Returns a sorted list of the elements of list, keeping only the first
+// occurrence of elements that compare equal and removing duplicates. This
+// function only works with constraint Ordered list.
+func USort[T constraints.Ordered](list List[T]) List[T] {
+    return USortFunc(list, cmp.Compare[T])
+}
+
+// Returns a list containing the elements of input list sorted as determined
+// by cmp, keeping only the first occurrence of elements that compare equal.
+func USortFunc[T any](list List[T], cmp func(a, b T) int) List[T] {
+    elems := ToSlice(list)
+    slices.SortStableFunc(elems, cmp)
+    elems = slices.CompactFunc(elems, func(a, b T) bool { return cmp(a, b) == 0 })
+    return FromSlice(elems)
+}
+
+// Returns a list that element at specific index is updated with returns value
+// of fun. If index is out of bound, the original list is returned. Negative
+// index indicate an offset from the end of list. See TryUpdateAt for a variant
+// returning an error.
+func UpdateAt[T any](list List[T], index int, fun func(T) T) List[T] {
+    result, err := TryUpdateAt(list, index, fun)
+    if err != nil {
+        return Concat(list)
+    }
+    return result
+}
+
+/*
+ *******************************************************************************
+ * Exported methods
+ *******************************************************************************
+ */
+
+// Returns an iterator over elements of list, from first to last.
+func (list List[T]) All() iter.Seq[T] {
+    return func(yield func(T) bool) {
+        for chunk := list.head; chunk != nil; chunk = chunk.next {
+            for _, elem := range chunk.values[:chunk.count] {
+                if !yield(elem) {
+                    return
+                }
+            }
+        }
+    }
+}
+
+// Returns an iterator over index and element of list, from first to last.
+func (list List[T]) Enumerate() iter.Seq2[int, T] {
+    return func(yield func(int, T) bool) {
+        i := 0
+        for elem := range list.All() {
+            if !yield(i, elem) {
+                return
+            }
+            i++
+        }
+    }
+}
+
+// Returns a string representing the unrolled linked list, in the same format
+// as golist.GoList.
+func (list List[T]) String() string {
+    var builder strings.Builder
+    list.WriteTo(&builder)
+    return builder.String()
+}
+
+// Writes the same text as String method into w, element by element, without
+// building the whole string in memory. Returns the number of bytes written.
+// Implements io.WriterTo.
+func (list List[T]) WriteTo(w io.Writer) (int64, error) {
+    return listtext.Write(textFormat, w, list.All())
+}
+
+/*
+ *******************************************************************************
+ * Exported in-place methods
+ *
+ * Unlike the functions above, which always return a new list and leave their
+ * input untouched, these methods modify the list they are called on, and the
+ * nodes it shares with copies made by assignment.
+ *******************************************************************************
+ */
+
+// Inserts value at the front of list, shifting elements of the first node.
+func (list *List[T]) PushFront(value T) {
+    head := list.head
+    if head == nil || head.count == chunkSize {
+        head = &chunk[T]{next: list.head}
+        if list.head == nil {
+            list.tail = head
+        }
+        list.head = head
+    }
+    copy(head.values[1:head.count+1], head.values[:head.count])
+    head.values[0] = value
+    head.count++
+    list.size++
+}
+
+// Inserts value at the back of list.
+func (list *List[T]) PushBack(value T) {
+    list.append(value)
+}
+
+// Removes the first element of list. Returns its value and true, or zero value
+// and false if list is empty.
+func (list *List[T]) PopFront() (T, bool) {
+    var zero T
+    head := list.head
+    if head == nil {
+        return zero, false
+    }
+    value := head.values[0]
+    copy(head.values[:head.count-1], head.values[1:head.count])
+    head.count--
+    head.values[head.count] = zero
+    if head.count == 0 {
+        list.head = head.next
+        if list.head == nil {
+            list.tail = nil
+        }
+    }
+    list.size--
+    return value, true
+}
+
+// Removes the last element of list. Returns its value and true, or zero value
+// and false if list is empty. Walks the list when the last node becomes empty,
+// so it is O(n / 64).
+func (list *List[T]) PopBack() (T, bool) {
+    var zero T
+    tail := list.tail
+    if tail == nil {
+        return zero, false
+    }
+    tail.count--
+    value := tail.values[tail.count]
+    tail.values[tail.count] = zero
+    if tail.count == 0 {
+        list.removeTail()
+    }
+    list.size--
+    return value, true
+}
+
+/*
+ *******************************************************************************
+ * Internal functions and methods
+ *******************************************************************************
+ */
+
+// Returns true if elements of every input list are in ascending order as
+// determined by cmp.
+func allSortedFunc[T any](lists []List[T], cmp func(a, b T) int) bool {
+    for _, list := range lists {
+        first := true
+        var prev T
+        for elem := range list.All() {
+            if !first && cmp(elem, prev) < 0 {
+                return false
+            }
+            first, prev = false, elem
+        }
+    }
+    return true
+}
+
+// Do k-way merge sorted input lists, using a heap of the current element of
+// each list. If unique is true, elements that compare equal to the previous
+// merged element are skipped.
+func mergeFunc[T any](lists []List[T], cmp func(a, b T) int, unique bool) List[T] {
+    h := &mergeHeap[T]{cmp: cmp}
+    for i, list := range lists {
+        if list.head != nil {
+            h.items = append(h.items, mergeItem[T]{chunk: list.head, list: i})
+        }
+    }
+    heap.Init(h)
+
+    var result List[T]
+    for h.Len() > 0 {
+        item := &h.items[0]
+        elem := item.elem()
+        if !unique || result.tail == nil || cmp(elem, Last(result)) != 0 {
+            result.append(elem)
+        }
+        if item.offset++; item.offset == item.chunk.count {
+            item.chunk, item.offset = item.chunk.next, 0
+        }
+        if item.chunk != nil {
+            heap.Fix(h, 0)
+        } else {
+            heap.Pop(h)
+        }
+    }
+    return result
+}
+
+// Current element of an input list of mergeFunc, as its node and offset in
+// the node, and position of that list.
+type mergeItem[T any] struct {
+    chunk  *chunk[T]
+    offset int
+    list   int
+}
+
+// Returns the current element of item.
+func (item mergeItem[T]) elem() T {
+    return item.chunk.values[item.offset]
+}
+
+// Min-heap of mergeItem, implementing heap.Interface. Items are ordered by
+// element, then by list position so that merging is stable.
+type mergeHeap[T any] struct {
+    items []mergeItem[T]
+    cmp   func(a, b T) int
+}
+
+func (h *mergeHeap[T]) Len() int {
+    return len(h.items)
+}
+
+func (h *mergeHeap[T]) Less(i, j int) bool {
+    c := h.cmp(h.items[i].elem(), h.items[j].elem())
+    return c < 0 || c == 0 && h.items[i].list < h.items[j].list
+}
+
+func (h *mergeHeap[T]) Swap(i, j int) {
+    h.items[i], h.items[j] = h.items[j], h.items[i]
+}
+
+func (h *mergeHeap[T]) Push(x any) {
+    h.items = append(h.items, x.(mergeItem[T]))
+}
+
+func (h *mergeHeap[T]) Pop() any {
+    last := h.items[len(h.items)-1]
+    h.items = h.items[:len(h.items)-1]
+    return last
+}
+
+// Do append value to the last node of list, or to a new node if it is full.
+func (list *List[T]) append(value T) {
+    if list.tail == nil || list.tail.count == chunkSize {
+        chunk := &chunk[T]{}
+        if list.tail == nil {
+            list.head = chunk
+        } else {
+            list.tail.next = chunk
+        }
+        list.tail = chunk
+    }
+    list.tail.values[list.tail.count] = value
+    list.tail.count++
+    list.size++
+}
+
+// Returns the node holding element at index and offset of the element in the
+// node. index must be in range.
+func (list List[T]) locate(index int) (*chunk[T], int) {
+    chunk := list.head
+    for index >= chunk.count {
+        index -= chunk.count
+        chunk = chunk.next
+    }
+    return chunk, index
+}
+
+// Do remove the empty last node of list.
+func (list *List[T]) removeTail() {
+    if list.head == list.tail {
+        list.head, list.tail = nil, nil
+        return
+    }
+    prev := list.head
+    for prev.next != list.tail {
+        prev = prev.next
+    }
+    prev.next = nil
+    list.tail = prev
+}
+
+// Returns a set containing the elements of list.
+func toSet[T comparable](list List[T]) map[T]struct{} {
+    set := make(map[T]struct{}, list.size)
+    for elem := range list.All() {
+        set[elem] = struct{}{}
+    }
+    return set
+}
+
+// Returns index converted into a non-negative index of a list of length len,
+// or ErrEmptyList or ErrIndexOutOfRange.
+func elemIndex(index, len int) (int, error) {
+    if len == 0 {
+        return 0, ErrEmptyList
+    }
+    if index < 0 {
+        index = len + index // same as len - abs(index)
+    }
+    if index < 0 || index >= len {
+        return 0, ErrIndexOutOfRange
+    }
+    return index, nil
+}
@@ -0,0 +1,355 @@
+package unrolled
+
+import (
+    "errors"
+    "slices"
+    "testing"
+    "github.com/hiennguyen-neih/go-linkedlist/golist"
+    "github.com/hiennguyen-neih/go-linkedlist/golist2"
+)
+
+// Returns a list spanning several nodes, containing 0, 1, ..., n - 1.
+func sequence(n int) List[int] {
+    var result List[int]
+    for i := 0; i < n; i++ {
+        result.PushBack(i)
+    }
+    return result
+}
+
+// Checks that size and tail of list match its nodes.
+func checkInvariants[T any](t *testing.T, name string, list List[T]) {
+    t.Helper()
+    size := 0
+    var last *chunk[T]
+    for chunk := list.head; chunk != nil; chunk = chunk.next {
+        if chunk.count == 0 {
+            t.Errorf("%s\nempty node in list", name)
+        }
+        size += chunk.count
+        last = chunk
+    }
+    if size != list.size || last != list.tail {
+        t.Errorf("%s\nsize: %v, tail: %p\nexpected size: %v, tail: %p", name, list.size, list.tail, size, last)
+    }
+}
+
+func TestConversions(t *testing.T) {
+    list := sequence(200)
+    checkInvariants(t, "PushBack", list)
+    if result, expected := ToSlice(list), slices.Collect(golist.Seq(0, 199, 1).All()); !slices.Equal(result, expected) {
+        t.Errorf("ToSlice\nresult: %v\nexpected: %v", result, expected)
+    }
+    if result := FromList(ToList(list)); !Equal(result, list) {
+        t.Errorf("FromList\nresult: %v\nexpected: %v", result, list)
+    }
+    if result := FromList2(ToList2(list)); !Equal(result, list) {
+        t.Errorf("FromList2\nresult: %v\nexpected: %v", result, list)
+    }
+    if result, expected := ToList2(New("a", "b")), golist2.New("a", "b"); !golist2.Equal(result, expected) {
+        t.Errorf("ToList2\nresult: %v\nexpected: %v", result, expected)
+    }
+}
+
+func TestString(t *testing.T) {
+    if result, expected := New(1, 2, 3).String(), "[1->2->3]"; result != expected {
+        t.Errorf("String\nresult: %v\nexpected: %v", result, expected)
+    }
+    if result, expected := New("a").String(), `["a"]`; result != expected {
+        t.Errorf("String\nresult: %v\nexpected: %v", result, expected)
+    }
+}
+
+func TestNth(t *testing.T) {
+    list := sequence(300)
+    for _, index := range []int{0, 63, 64, 65, 299} {
+        if result := Nth(list, index); result != index {
+            t.Errorf("Nth\nresult: %v\nexpected: %v", result, index)
+        }
+    }
+    if result := Nth(list, -1); result != 299 {
+        t.Errorf("Nth\nresult: %v\nexpected: %v", result, 299)
+    }
+    if _, err := TryNth(list, 300); err != ErrIndexOutOfRange {
+        t.Errorf("TryNth\nresult: %v\nexpected: %v", err, ErrIndexOutOfRange)
+    }
+    if _, err := TryNth(New[int](), 0); err != ErrEmptyList {
+        t.Errorf("TryNth\nresult: %v\nexpected: %v", err, ErrEmptyList)
+    }
+    if result := Last(list); result != 299 {
+        t.Errorf("Last\nresult: %v\nexpected: %v", result, 299)
+    }
+}
+
+func TestInsertDeleteAt(t *testing.T) {
+    list := sequence(100)
+    inserted := InsertAt(list, 64, -1)
+    checkInvariants(t, "InsertAt", inserted)
+    if result := Nth(inserted, 64); result != -1 || Len(inserted) != 101 {
+        t.Errorf("InsertAt\nresult: %v, %v\nexpected: -1, 101", result, Len(inserted))
+    }
+    if result := InsertAt(list, 100, -1); Last(result) != -1 {
+        t.Errorf("InsertAt\nresult: %v\nexpected: %v", Last(result), -1)
+    }
+    if result := DeleteAt(inserted, 64); !Equal(result, list) {
+        t.Errorf("DeleteAt\nresult: %v\nexpected: %v", result, list)
+    }
+    if _, err := TryInsertAt(list, 101, 0); err != ErrIndexOutOfRange {
+        t.Errorf("TryInsertAt\nresult: %v\nexpected: %v", err, ErrIndexOutOfRange)
+    }
+    if result := DeleteAt(list, 100); !Equal(result, list) {
+        t.Errorf("DeleteAt\nresult: %v\nexpected: %v", result, list)
+    }
+    if result := Nth(ReplaceAt(list, -1, 7), 99); result != 7 || Nth(list, 99) != 99 {
+        t.Errorf("ReplaceAt\nresult: %v\nexpected: %v", result, 7)
+    }
+}
+
+func TestSublist(t *testing.T) {
+    list := sequence(200)
+    if result, expected := ToSlice(Sublist(list, 60, 10)), []int{60, 61, 62, 63, 64, 65, 66, 67, 68, 69}; !slices.Equal(result, expected) {
+        t.Errorf("Sublist\nresult: %v\nexpected: %v", result, expected)
+    }
+    if result := Sublist(list, -2, 10); !Equal(result, New(198, 199)) {
+        t.Errorf("Sublist\nresult: %v\nexpected: %v", result, New(198, 199))
+    }
+    if _, err := TrySublist(list, 0, -1); !errors.Is(err, ErrNegativeLength) {
+        t.Errorf("TrySublist\nresult: %v\nexpected: %v", err, ErrNegativeLength)
+    }
+}
+
+func TestFunctional(t *testing.T) {
+    list := sequence(1000)
+    isEven := func(x int) bool { return x%2 == 0 }
+    if result := Len(Filter(list, isEven)); result != 500 {
+        t.Errorf("Filter\nresult: %v\nexpected: %v", result, 500)
+    }
+    if result := Sum(Map(list, func(x int) int { return x * 2 })); result != 999000 {
+        t.Errorf("Map\nresult: %v\nexpected: %v", result, 999000)
+    }
+    if result := Foldl(list, 0, func(x, acc int) int { return acc + x }); result != 499500 {
+        t.Errorf("Foldl\nresult: %v\nexpected: %v", result, 499500)
+    }
+    if result := Foldr(New(1, 2, 3), []int{}, func(x int, acc []int) []int { return append(acc, x) }); !slices.Equal(result, []int{3, 2, 1}) {
+        t.Errorf("Foldr\nresult: %v\nexpected: %v", result, []int{3, 2, 1})
+    }
+    if result := IndexFunc(list, func(x int) bool { return x > 500 }); result != 501 {
+        t.Errorf("IndexFunc\nresult: %v\nexpected: %v", result, 501)
+    }
+    if !All(list, func(x int) bool { return x >= 0 }) || Any(list, func(x int) bool { return x < 0 }) {
+        t.Errorf("All\nresult: false\nexpected: true")
+    }
+    if !Member(list, 999) || Member(list, 1000) {
+        t.Errorf("Member\nresult: false\nexpected: true")
+    }
+    if result := FilterMap(New(1, 2, 3), func(x int) (bool, string) { return x != 2, "x" }); !Equal(result, New("x", "x")) {
+        t.Errorf("FilterMap\nresult: %v\nexpected: %v", result, New("x", "x"))
+    }
+    if result := Concat(New(1), Append(New(2), 3), AppendHead(New(5), 4)); !Equal(result, New(1, 2, 3, 4, 5)) {
+        t.Errorf("Concat\nresult: %v\nexpected: %v", result, New(1, 2, 3, 4, 5))
+    }
+}
+
+func TestFunctional_Golist(t *testing.T) {
+    list := New(1, 2, 3, 2, 1)
+    isSmall := func(x int) bool { return x < 3 }
+    tests := []struct {
+        name     string
+        result   List[int]
+        expected List[int]
+    }{
+        {"Delete", Delete(list, 2), New(1, 3, 2, 1)},
+        {"DeleteFunc", DeleteFunc(list, func(x int) bool { return x > 2 }), New(1, 2, 2, 1)},
+        {"DropLast", DropLast(list), New(1, 2, 3, 2)},
+        {"DropWhile", DropWhile(list, isSmall), New(3, 2, 1)},
+        {"TakeWhile", TakeWhile(list, isSmall), New(1, 2)},
+        {"Duplicate", Duplicate(3, 7), New(7, 7, 7)},
+        {"FlatMap", FlatMap(New(1, 2), func(x int) List[int] { return Duplicate(x, x) }), New(1, 2, 2)},
+        {"Join", Join(New(1, 2, 3), 0), New(1, 0, 2, 0, 3)},
+        {"NthTail", NthTail(list, -2), New(2, 1)},
+        {"Seq", Seq(1, 10, 4), New(1, 5, 9)},
+        {"Merge", Merge(New(1, 4), New(2, 3, 5)), New(1, 2, 3, 4, 5)},
+        {"UMerge", UMerge(New(1, 2), New(2, 3)), New(1, 2, 3)},
+        {"USort", USort(list), New(1, 2, 3)},
+        {"Subtract", Subtract(list, New(1, 2)), New(3, 2, 1)},
+        {"SubtractFunc", SubtractFunc(list, New(1, 2), func(a, b int) bool { return a == b }), New(3, 2, 1)},
+        {"Uniq", Uniq(list), New(1, 2, 3)},
+        {"Union", Union(New(1, 2), New(3, 1)), New(1, 2, 3)},
+        {"Intersect", Intersect(list, New(3, 1)), New(1, 3)},
+        {"Difference", Difference(list, New(2)), New(1, 3)},
+        {"SymmetricDifference", SymmetricDifference(New(1, 2), New(2, 3)), New(1, 3)},
+    }
+    for _, test := range tests {
+        if !Equal(test.result, test.expected) {
+            t.Errorf("%s\nresult: %v\nexpected: %v", test.name, test.result, test.expected)
+        }
+        checkInvariants(t, test.name, test.result)
+    }
+
+    list1, list2 := Split(list, 2)
+    if !Equal(list1, New(1, 2)) || !Equal(list2, New(3, 2, 1)) {
+        t.Errorf("Split\nresult: %v, %v\nexpected: %v, %v", list1, list2, New(1, 2), New(3, 2, 1))
+    }
+    list1, list2 = SplitWith(list, isSmall)
+    if !Equal(list1, New(1, 2)) || !Equal(list2, New(3, 2, 1)) {
+        t.Errorf("SplitWith\nresult: %v, %v\nexpected: %v, %v", list1, list2, New(1, 2), New(3, 2, 1))
+    }
+    list1, list2 = Partition(list, isSmall)
+    if !Equal(list1, New(1, 2, 2, 1)) || !Equal(list2, New(3)) {
+        t.Errorf("Partition\nresult: %v, %v\nexpected: %v, %v", list1, list2, New(1, 2, 2, 1), New(3))
+    }
+    if _, _, err := TrySplit(list, 5); !errors.Is(err, ErrIndexOutOfRange) {
+        t.Errorf("TrySplit\nresult: %v\nexpected: %v", err, ErrIndexOutOfRange)
+    }
+    sums, total := MapFoldl(New(1, 2, 3), 0, func(x, acc int) (int, int) { return acc + x, acc + x })
+    if !Equal(sums, New(1, 3, 6)) || total != 6 {
+        t.Errorf("MapFoldl\nresult: %v, %v\nexpected: %v, %v", sums, total, New(1, 3, 6), 6)
+    }
+    sums, total = MapFoldr(New(1, 2, 3), 0, func(x, acc int) (int, int) { return acc + x, acc + x })
+    if !Equal(sums, New(6, 5, 3)) || total != 6 {
+        t.Errorf("MapFoldr\nresult: %v, %v\nexpected: %v, %v", sums, total, New(6, 5, 3), 6)
+    }
+    if !Prefix(New(1, 2), list) || Prefix(New(2), list) || !Suffix(New(2, 1), list) || Suffix(list, New(1)) {
+        t.Errorf("Prefix\nresult: false\nexpected: true")
+    }
+    if index, elem := Search(list, func(x int) bool { return x > 2 }); index != 2 || elem != 3 {
+        t.Errorf("Search\nresult: %v, %v\nexpected: %v, %v", index, elem, 2, 3)
+    }
+    if result := Find(list, 2); result != 1 {
+        t.Errorf("Find\nresult: %v\nexpected: %v", result, 1)
+    }
+    if result := Find(sequence(300), 299); result != 299 {
+        t.Errorf("Find\nresult: %v\nexpected: %v", result, 299)
+    }
+}
+
+func TestMerge(t *testing.T) {
+    evens := Filter(sequence(1000), func(x int) bool { return x%2 == 0 })
+    odds := Filter(sequence(1000), func(x int) bool { return x%2 == 1 })
+    if result := Merge(evens, New[int](), odds); !Equal(result, sequence(1000)) {
+        t.Errorf("Merge\nresult: %v\nexpected: %v", result, sequence(1000))
+    }
+    if result := UMerge(sequence(300), evens, sequence(300)); !Equal(result, USort(Concat(sequence(300), evens))) {
+        t.Errorf("UMerge\nresult: %v\nexpected: %v", result, USort(Concat(sequence(300), evens)))
+    }
+    type pair struct{ key, list int }
+    byKey := func(a, b pair) int { return a.key - b.key }
+    merged := MergeFunc(byKey, New(pair{1, 0}, pair{2, 0}), New(pair{1, 1}, pair{3, 1}))
+    expected := New(pair{1, 0}, pair{1, 1}, pair{2, 0}, pair{3, 1})
+    if !Equal(merged, expected) {
+        t.Errorf("MergeFunc\nresult: %v\nexpected: %v", merged, expected)
+    }
+    checkInvariants(t, "MergeFunc", merged)
+}
+
+func TestEmptyList(t *testing.T) {
+    empty := New[int]()
+    if Last(empty) != 0 || Max(empty) != 0 || Min(empty) != 0 {
+        t.Errorf("Last\nresult: %v, %v, %v\nexpected: 0, 0, 0", Last(empty), Max(empty), Min(empty))
+    }
+    if _, err := TryLast(empty); !errors.Is(err, ErrEmptyList) {
+        t.Errorf("TryLast\nresult: %v\nexpected: %v", err, ErrEmptyList)
+    }
+    if _, err := TryMax(empty); !errors.Is(err, ErrEmptyList) {
+        t.Errorf("TryMax\nresult: %v\nexpected: %v", err, ErrEmptyList)
+    }
+    if _, err := TryMin(empty); !errors.Is(err, ErrEmptyList) {
+        t.Errorf("TryMin\nresult: %v\nexpected: %v", err, ErrEmptyList)
+    }
+    if result, err := TryLast(sequence(100)); err != nil || result != 99 {
+        t.Errorf("TryLast\nresult: %v, %v\nexpected: %v, %v", result, err, 99, nil)
+    }
+    if result := DropLast(empty); Len(result) != 0 {
+        t.Errorf("DropLast\nresult: %v\nexpected: []", result)
+    }
+}
+
+func TestParse(t *testing.T) {
+    strs, err := ParseStrings(`["a->b"->"c"]`)
+    if err != nil || !Equal(strs, New("a->b", "c")) {
+        t.Errorf("ParseStrings\nresult: %v, %v\nexpected: %v", strs, err, New("a->b", "c"))
+    }
+    list := sequence(200)
+    numbers, err := ParseNumbers[int](list.String())
+    if err != nil || !Equal(numbers, list) {
+        t.Errorf("ParseNumbers\nresult: %v\nexpected: %v", err, nil)
+    }
+    checkInvariants(t, "ParseNumbers", numbers)
+    if _, err := ParseNumbers[int]("[1->x]"); !errors.Is(err, ErrSyntax) {
+        t.Errorf("ParseNumbers\nresult: %v\nexpected: %v", err, ErrSyntax)
+    }
+}
+
+func TestSort(t *testing.T) {
+    list := Reverse(sequence(500))
+    if result := Sort(list); !Equal(result, sequence(500)) {
+        t.Errorf("Sort\nresult: %v\nexpected: %v", result, sequence(500))
+    }
+    if Max(list) != 499 || Min(list) != 0 {
+        t.Errorf("Max\nresult: %v, %v\nexpected: 499, 0", Max(list), Min(list))
+    }
+    type person struct {
+        name string
+        age  int
+    }
+    people := New(person{"a", 2}, person{"b", 1}, person{"c", 2})
+    byAge := func(a, b person) int { return a.age - b.age }
+    expected := New(person{"b", 1}, person{"a", 2}, person{"c", 2})
+    if result := SortStableFunc(people, byAge); !Equal(result, expected) {
+        t.Errorf("SortStableFunc\nresult: %v\nexpected: %v", result, expected)
+    }
+}
+
+func TestPushPop(t *testing.T) {
+    var list List[int]
+    for i := 0; i < 150; i++ {
+        list.PushFront(i)
+    }
+    checkInvariants(t, "PushFront", list)
+    if result := Nth(list, 0); result != 149 {
+        t.Errorf("PushFront\nresult: %v\nexpected: %v", result, 149)
+    }
+    for i := 0; i < 100; i++ {
+        if value, ok := list.PopFront(); !ok || value != 149-i {
+            t.Fatalf("PopFront\nresult: %v\nexpected: %v", value, 149-i)
+        }
+    }
+    checkInvariants(t, "PopFront", list)
+    for i := 0; i < 50; i++ {
+        if value, ok := list.PopBack(); !ok || value != i {
+            t.Fatalf("PopBack\nresult: %v\nexpected: %v", value, i)
+        }
+    }
+    checkInvariants(t, "PopBack", list)
+    if _, ok := list.PopBack(); ok {
+        t.Errorf("PopBack\nresult: true\nexpected: false")
+    }
+}
+
+func BenchmarkTraverse(b *testing.B) {
+    list := sequence(1000000)
+    b.ResetTimer()
+    for i := 0; i < b.N; i++ {
+        Sum(list)
+    }
+}
+
+func BenchmarkTraverse_GoList(b *testing.B) {
+    list := golist.Seq(0, 999999, 1)
+    b.ResetTimer()
+    for i := 0; i < b.N; i++ {
+        golist.Sum(list)
+    }
+}
+
+func BenchmarkBuild(b *testing.B) {
+    for i := 0; i < b.N; i++ {
+        sequence(100000)
+    }
+}
+
+func BenchmarkBuild_GoList(b *testing.B) {
+    for i := 0; i < b.N; i++ {
+        golist.Seq(0, 99999, 1)
+    }
+}